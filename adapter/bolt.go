@@ -0,0 +1,194 @@
+package adapter
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	bolt "go.etcd.io/bbolt"
+	"reflect"
+)
+
+type (
+	// Codec decodes the stored values into the destination elements
+	Codec interface {
+		Unmarshal(data []byte, v interface{}) error
+	}
+
+	// CodecFunc function implementing Codec
+	CodecFunc func(data []byte, v interface{}) error
+
+	// BoltOptions bucket pagination options
+	BoltOptions struct {
+		// Prefix paginates only the keys having this prefix
+		Prefix []byte
+		// Start paginates only the keys greater than or equal to Start
+		Start []byte
+		// End paginates only the keys less than End
+		End []byte
+		// Codec decodes the values, JSONCodec by default
+		Codec Codec
+	}
+
+	// BoltAdapter bbolt adapter to be passed to paginator constructor to paginate the values of a bucket in key order.
+	BoltAdapter struct {
+		db     *bolt.DB
+		bucket []byte
+		opts   BoltOptions
+	}
+)
+
+var (
+	// JSONCodec decodes JSON values
+	JSONCodec Codec = CodecFunc(json.Unmarshal)
+
+	// GobCodec decodes gob values
+	GobCodec Codec = CodecFunc(func(data []byte, v interface{}) error {
+		return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+	})
+
+	// ErrBucketNotFound the bucket doesn't exist
+	ErrBucketNotFound = errors.New("bucket not found")
+)
+
+// Unmarshal calls f(data, v)
+func (f CodecFunc) Unmarshal(data []byte, v interface{}) error {
+	return f(data, v)
+}
+
+// NewBoltAdapter bbolt adapter constructor receive the database and the name of the bucket to paginate.
+func NewBoltAdapter(db *bolt.DB, bucket []byte, opts BoltOptions) paginator.Adapter {
+	if opts.Codec == nil {
+		opts.Codec = JSONCodec
+	}
+
+	return &BoltAdapter{db: db, bucket: bucket, opts: opts}
+}
+
+// Nums returns the number of values
+func (a *BoltAdapter) Nums() (int64, error) {
+	var n int64
+	err := a.view(func(c *bolt.Cursor) error {
+		for k, v := a.first(c); a.inRange(k); k, v = c.Next() {
+			if v != nil {
+				n++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// Slice stores into dest argument a slice of the decoded values.
+// dest argument must be a pointer to a slice
+func (a *BoltAdapter) Slice(offset, length int, dest interface{}) error {
+	return a.view(func(c *bolt.Cursor) error {
+		k, v := a.first(c)
+		for skipped := 0; a.inRange(k) && skipped < offset; k, v = c.Next() {
+			if v != nil {
+				skipped++
+			}
+		}
+
+		_, err := a.decode(c, k, v, length, dest)
+
+		return err
+	})
+}
+
+// SliceFrom stores into dest argument a slice of at most length decoded values, starting with the key
+// greater than or equal to key. A nil key starts with the first key.
+// It returns the key the next page starts with or nil if there are no more values,
+// so pages can be read with a key cursor instead of an offset.
+func (a *BoltAdapter) SliceFrom(key []byte, length int, dest interface{}) ([]byte, error) {
+	var next []byte
+	err := a.view(func(c *bolt.Cursor) error {
+		k, v := a.first(c)
+		if key != nil && bytes.Compare(key, k) > 0 {
+			k, v = c.Seek(key)
+		}
+
+		var err error
+		next, err = a.decode(c, k, v, length, dest)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return next, nil
+}
+
+// decode stores into dest the values starting with the cursor at k and returns the key following them
+func (a *BoltAdapter) decode(c *bolt.Cursor, k, v []byte, length int, dest interface{}) ([]byte, error) {
+	if err := makeSlice(dest, 0, length); err != nil {
+		return nil, err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	elemType := vt.Type().Elem()
+	for ; a.inRange(k); k, v = c.Next() {
+		if v == nil {
+			continue
+		}
+
+		if vt.Len() == length {
+			return append([]byte(nil), k...), nil
+		}
+
+		elem := reflect.New(elemType)
+		if err := a.opts.Codec.Unmarshal(v, elem.Interface()); err != nil {
+			return nil, fmt.Errorf("bolt: key %q: %w", k, err)
+		}
+
+		vt.Set(reflect.Append(vt, elem.Elem()))
+	}
+
+	return nil, nil
+}
+
+func (a *BoltAdapter) view(fn func(c *bolt.Cursor) error) error {
+	return a.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(a.bucket)
+		if b == nil {
+			return fmt.Errorf("%w: %s", ErrBucketNotFound, a.bucket)
+		}
+
+		return fn(b.Cursor())
+	})
+}
+
+// first moves the cursor to the first key of the range
+func (a *BoltAdapter) first(c *bolt.Cursor) ([]byte, []byte) {
+	start := a.opts.Start
+	if a.opts.Prefix != nil && bytes.Compare(a.opts.Prefix, start) > 0 {
+		start = a.opts.Prefix
+	}
+
+	if start == nil {
+		return c.First()
+	}
+
+	return c.Seek(start)
+}
+
+// inRange returns true if the key is not past the end of the range
+func (a *BoltAdapter) inRange(k []byte) bool {
+	if k == nil {
+		return false
+	}
+
+	if a.opts.Prefix != nil && !bytes.HasPrefix(k, a.opts.Prefix) {
+		return false
+	}
+
+	return a.opts.End == nil || bytes.Compare(k, a.opts.End) < 0
+}
@@ -0,0 +1,181 @@
+package adapter_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	bolt "go.etcd.io/bbolt"
+	"path/filepath"
+	"testing"
+)
+
+type BoltAdapterTestSuite struct {
+	suite.Suite
+	db *bolt.DB
+}
+
+func (suite *BoltAdapterTestSuite) SetupTest() {
+	require := suite.Require()
+
+	db, err := bolt.Open(filepath.Join(suite.T().TempDir(), "test.db"), 0600, nil)
+	require.NoError(err)
+	suite.db = db
+
+	require.NoError(db.Update(func(tx *bolt.Tx) error {
+		posts, err := tx.CreateBucket([]byte("posts"))
+		if err != nil {
+			return err
+		}
+
+		for i := 1; i <= 100; i++ {
+			data, err := json.Marshal(Post{ID: uint(i), Number: i})
+			if err != nil {
+				return err
+			}
+
+			if err := posts.Put([]byte(fmt.Sprintf("post:%03d", i)), data); err != nil {
+				return err
+			}
+		}
+
+		for i := 1; i <= 5; i++ {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(Post{ID: uint(i), Number: -i}); err != nil {
+				return err
+			}
+
+			if err := posts.Put([]byte(fmt.Sprintf("draft:%03d", i)), buf.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		// nested buckets are skipped
+		_, err = posts.CreateBucket([]byte("post:050-comments"))
+
+		return err
+	}))
+}
+
+func (suite *BoltAdapterTestSuite) TearDownTest() {
+	suite.Require().NoError(suite.db.Close())
+}
+
+func (suite *BoltAdapterTestSuite) newPaginator(opts adapter.BoltOptions) paginator.Paginator {
+	return paginator.New(adapter.NewBoltAdapter(suite.db, []byte("posts"), opts), 10)
+}
+
+func (suite *BoltAdapterTestSuite) TestFirstPage() {
+	p := suite.newPaginator(adapter.BoltOptions{Prefix: []byte("post:")})
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	require.Equal(Post{ID: 1, Number: 1}, posts[0])
+}
+
+func (suite *BoltAdapterTestSuite) TestCurrentPageResults() {
+	p := suite.newPaginator(adapter.BoltOptions{Prefix: []byte("post:")})
+
+	require := suite.Require()
+	var posts []Post
+	p.SetPage(6)
+
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(51 + i), Number: 51 + i}, post)
+	}
+}
+
+func (suite *BoltAdapterTestSuite) TestRange() {
+	p := suite.newPaginator(adapter.BoltOptions{Start: []byte("post:095"), End: []byte("post:099")})
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(4, n)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Equal([]Post{{95, 95}, {96, 96}, {97, 97}, {98, 98}}, posts)
+}
+
+func (suite *BoltAdapterTestSuite) TestGobCodec() {
+	p := suite.newPaginator(adapter.BoltOptions{Prefix: []byte("draft:"), Codec: adapter.GobCodec})
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(5, n)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 5)
+	require.Equal(Post{ID: 5, Number: -5}, posts[4])
+}
+
+func (suite *BoltAdapterTestSuite) TestSliceFrom() {
+	a := adapter.NewBoltAdapter(suite.db, []byte("posts"), adapter.BoltOptions{Prefix: []byte("post:")})
+
+	require := suite.Require()
+	var (
+		key   []byte
+		pages int
+		all   []Post
+	)
+
+	for {
+		var posts []Post
+		next, err := a.(*adapter.BoltAdapter).SliceFrom(key, 30, &posts)
+		require.NoError(err)
+
+		pages++
+		all = append(all, posts...)
+		if next == nil {
+			break
+		}
+
+		key = next
+	}
+
+	require.Equal(4, pages)
+	require.Len(all, 100)
+	require.Equal(Post{ID: 100, Number: 100}, all[99])
+
+	var posts []Post
+	next, err := a.(*adapter.BoltAdapter).SliceFrom([]byte("post:098"), 30, &posts)
+	require.NoError(err)
+	require.Nil(next)
+	require.Equal([]Post{{98, 98}, {99, 99}, {100, 100}}, posts)
+}
+
+func (suite *BoltAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	_, err := adapter.NewBoltAdapter(suite.db, []byte("missing"), adapter.BoltOptions{}).Nums()
+	require.True(errors.Is(err, adapter.ErrBucketNotFound))
+
+	// the drafts are gob encoded
+	var posts []Post
+	err = suite.newPaginator(adapter.BoltOptions{}).Results(&posts)
+	require.Error(err)
+	require.Contains(err.Error(), `bolt: key "draft:001"`)
+}
+
+func TestBoltAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(BoltAdapterTestSuite))
+}
@@ -0,0 +1,246 @@
+package adapter
+
+import (
+	"container/list"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCacheMaxEntries default number of entries kept by the in-memory cache store
+const DefaultCacheMaxEntries = 1000
+
+var (
+	_ paginator.Peeker = (*CacheAdapter)(nil)
+
+	cacheNamespaces  uint64
+	cacheGenerations uint64
+)
+
+type (
+	// CacheStore storage of the cached results, e.g. an in-memory LRU or a shared cache.
+	// The values are the results of Nums, HasMore and copies of the slices stored by Slice.
+	// A value read back with another type, e.g. by a store serializing the values, is a cache miss.
+	CacheStore interface {
+		// Get returns the value of the key and true, or false if the key is missing or expired
+		Get(key string) (interface{}, bool)
+		// Set stores the value of the key for ttl, or without expiration if ttl is 0
+		Set(key string, value interface{}, ttl time.Duration)
+	}
+
+	// CacheOptions caching options
+	CacheOptions struct {
+		// Store the cache storage, an in-memory store holding MaxEntries entries by default
+		Store CacheStore
+		// TTL the time the results are cached for, 0 means until invalidated
+		TTL time.Duration
+		// MaxEntries the number of entries kept by the default store, DefaultCacheMaxEntries by default
+		MaxEntries int
+		// Namespace the prefix of the cache keys, unique to the adapter by default.
+		// Set it to share the cached results between adapters over the same data, e.g. across requests.
+		// The generation of the namespace is kept in the store under the "<namespace>:gen" key.
+		Namespace string
+	}
+
+	// CacheAdapter adapter wrapper caching the results of another adapter, e.g. a GORM adapter serving hot pages.
+	// Errors aren't cached.
+	CacheAdapter struct {
+		adapter   paginator.Adapter
+		store     CacheStore
+		ttl       time.Duration
+		namespace string
+	}
+
+	// MemoryCacheStore in-memory CacheStore evicting the least recently used entries above its size
+	MemoryCacheStore struct {
+		mu         sync.Mutex
+		maxEntries int
+		entries    map[string]*list.Element
+		lru        *list.List
+	}
+
+	memoryCacheEntry struct {
+		key     string
+		value   interface{}
+		expires time.Time
+	}
+)
+
+// NewCacheAdapter cache adapter constructor receive the inner adapter and the caching options.
+func NewCacheAdapter(adapter paginator.Adapter, opts CacheOptions) paginator.Adapter {
+	if opts.Store == nil {
+		opts.Store = NewMemoryCacheStore(opts.MaxEntries)
+	}
+
+	if opts.Namespace == "" {
+		opts.Namespace = fmt.Sprintf("paginator:%d", atomic.AddUint64(&cacheNamespaces, 1))
+	}
+
+	return &CacheAdapter{adapter: adapter, store: opts.Store, ttl: opts.TTL, namespace: opts.Namespace}
+}
+
+// Invalidate drops all the cached results of the namespace, e.g. after the underlying data changed,
+// including the ones cached by other adapters sharing the store and the namespace.
+// The entries of the previous results are no longer read and they expire or get evicted by the store.
+func (a *CacheAdapter) Invalidate() {
+	gen := fmt.Sprintf("%d.%d", time.Now().UnixNano(), atomic.AddUint64(&cacheGenerations, 1))
+	a.store.Set(a.namespace+":gen", gen, 0)
+}
+
+// Nums returns the cached number of items of the inner adapter
+func (a *CacheAdapter) Nums() (int64, error) {
+	key := a.key("nums")
+	if v, ok := a.store.Get(key); ok {
+		if n, ok := v.(int64); ok {
+			return n, nil
+		}
+	}
+
+	n, err := a.adapter.Nums()
+	if err != nil {
+		return 0, err
+	}
+
+	a.store.Set(key, n, a.ttl)
+
+	return n, nil
+}
+
+// HasMore returns the cached result of the inner adapter's HasMore
+func (a *CacheAdapter) HasMore(offset int) (bool, error) {
+	peeker, ok := a.adapter.(paginator.Peeker)
+	if !ok {
+		return false, paginator.ErrUnknownNums
+	}
+
+	key := a.key(fmt.Sprintf("more:%d", offset))
+	if v, ok := a.store.Get(key); ok {
+		if more, ok := v.(bool); ok {
+			return more, nil
+		}
+	}
+
+	more, err := peeker.HasMore(offset)
+	if err != nil {
+		return false, err
+	}
+
+	a.store.Set(key, more, a.ttl)
+
+	return more, nil
+}
+
+// Slice stores into dest argument a copy of the cached slice of the inner adapter.
+// dest argument must be a pointer to a slice
+func (a *CacheAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	key := a.key(fmt.Sprintf("slice:%d:%d:%s", offset, length, vt.Type()))
+	if v, ok := a.store.Get(key); ok {
+		if cached := reflect.ValueOf(v); cached.IsValid() && cached.Type() == vt.Type() {
+			vt.Set(copySlice(cached))
+
+			return nil
+		}
+	}
+
+	if err := a.adapter.Slice(offset, length, dest); err != nil {
+		return err
+	}
+
+	// keep a copy so changing the results doesn't change the cache
+	a.store.Set(key, copySlice(vt).Interface(), a.ttl)
+
+	return nil
+}
+
+// key returns the key of name in the current generation of the namespace
+func (a *CacheAdapter) key(name string) string {
+	gen, ok := a.store.Get(a.namespace + ":gen")
+	if !ok {
+		gen = 0
+	}
+
+	return fmt.Sprintf("%s:%v:%s", a.namespace, gen, name)
+}
+
+// copySlice returns a shallow copy of the slice
+func copySlice(v reflect.Value) reflect.Value {
+	c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(c, v)
+
+	return c
+}
+
+// NewMemoryCacheStore in-memory cache store constructor receive the maximum number of entries,
+// DefaultCacheMaxEntries if it's not positive.
+func NewMemoryCacheStore(maxEntries int) *MemoryCacheStore {
+	if maxEntries <= 0 {
+		maxEntries = DefaultCacheMaxEntries
+	}
+
+	return &MemoryCacheStore{maxEntries: maxEntries, entries: make(map[string]*list.Element), lru: list.New()}
+}
+
+// Get returns the value of the key and true, or false if the key is missing or expired
+func (s *MemoryCacheStore) Get(key string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*memoryCacheEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		s.lru.Remove(el)
+		delete(s.entries, key)
+
+		return nil, false
+	}
+
+	s.lru.MoveToFront(el)
+
+	return entry.value, true
+}
+
+// Set stores the value of the key for ttl, or without expiration if ttl is 0.
+// The least recently used entry is evicted when the store is full.
+func (s *MemoryCacheStore) Set(key string, value interface{}, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := &memoryCacheEntry{key: key, value: value}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+
+	if el, ok := s.entries[key]; ok {
+		el.Value = entry
+		s.lru.MoveToFront(el)
+
+		return
+	}
+
+	s.entries[key] = s.lru.PushFront(entry)
+	for s.lru.Len() > s.maxEntries {
+		el := s.lru.Back()
+		s.lru.Remove(el)
+		delete(s.entries, el.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Len returns the number of entries, including the expired ones not evicted yet
+func (s *MemoryCacheStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lru.Len()
+}
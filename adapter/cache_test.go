@@ -0,0 +1,225 @@
+package adapter_test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"testing"
+	"time"
+)
+
+type (
+	CacheAdapterTestSuite struct {
+		suite.Suite
+		data  []int
+		inner *spyAdapter
+	}
+
+	// jsonCacheStore store serializing the values like a shared cache does
+	jsonCacheStore struct {
+		entries map[string][]byte
+	}
+)
+
+func (s *jsonCacheStore) Get(key string) (interface{}, bool) {
+	data, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, false
+	}
+
+	return v, true
+}
+
+func (s *jsonCacheStore) Set(key string, value interface{}, _ time.Duration) {
+	if data, err := json.Marshal(value); err == nil {
+		s.entries[key] = data
+	}
+}
+
+func (suite *CacheAdapterTestSuite) SetupTest() {
+	suite.data = make([]int, 100)
+	for i := range suite.data {
+		suite.data[i] = i + 1
+	}
+
+	suite.inner = &spyAdapter{Adapter: adapter.NewSliceAdapter(&suite.data)}
+}
+
+func (suite *CacheAdapterTestSuite) TestCached() {
+	a := adapter.NewCacheAdapter(suite.inner, adapter.CacheOptions{})
+
+	require := suite.Require()
+	for i := 0; i < 3; i++ {
+		p := paginator.New(a, 10)
+		p.SetPage(2)
+
+		pn, err := p.PageNums()
+		require.NoError(err)
+		require.Equal(10, pn)
+
+		var items []int
+		require.NoError(p.Results(&items))
+		require.Equal([]int{11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, items)
+
+		// changing the results doesn't change the cache
+		items[0] = 0
+	}
+
+	require.Equal(1, suite.inner.nums)
+	require.Equal([][2]int{{10, 10}}, suite.inner.slices)
+
+	// the destination type is part of the key
+	var items []interface{}
+	require.NoError(a.Slice(10, 10, &items))
+	require.Equal(11, items[0])
+	require.Len(suite.inner.slices, 2)
+}
+
+func (suite *CacheAdapterTestSuite) TestInvalidate() {
+	a := adapter.NewCacheAdapter(suite.inner, adapter.CacheOptions{})
+
+	require := suite.Require()
+	var items []int
+	require.NoError(a.Slice(0, 5, &items))
+
+	suite.data[0] = -1
+	require.NoError(a.Slice(0, 5, &items))
+	require.Equal(1, items[0])
+
+	a.(*adapter.CacheAdapter).Invalidate()
+	require.NoError(a.Slice(0, 5, &items))
+	require.Equal(-1, items[0])
+	require.Len(suite.inner.slices, 2)
+}
+
+func (suite *CacheAdapterTestSuite) TestInvalidateSharedNamespace() {
+	opts := adapter.CacheOptions{Store: adapter.NewMemoryCacheStore(0), Namespace: "posts"}
+	a1 := adapter.NewCacheAdapter(suite.inner, opts)
+
+	require := suite.Require()
+	n, err := a1.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	suite.data = append(suite.data, 101)
+	a1.(*adapter.CacheAdapter).Invalidate()
+
+	// a new request's adapter reads the invalidated namespace
+	a2 := adapter.NewCacheAdapter(suite.inner, opts)
+	n, err = a2.Nums()
+	require.NoError(err)
+	require.EqualValues(101, n)
+	require.Equal(2, suite.inner.nums)
+
+	n, err = a1.Nums()
+	require.NoError(err)
+	require.EqualValues(101, n)
+	require.Equal(2, suite.inner.nums)
+}
+
+func (suite *CacheAdapterTestSuite) TestSerializingStore() {
+	store := &jsonCacheStore{entries: make(map[string][]byte)}
+	a := adapter.NewCacheAdapter(suite.inner, adapter.CacheOptions{Store: store, Namespace: "posts"})
+
+	require := suite.Require()
+	for i := 0; i < 2; i++ {
+		n, err := a.Nums()
+		require.NoError(err)
+		require.EqualValues(100, n)
+
+		var items []int
+		require.NoError(a.Slice(0, 3, &items))
+		require.Equal([]int{1, 2, 3}, items)
+	}
+
+	// the values read back with another type are cache misses
+	require.Equal(2, suite.inner.nums)
+	require.Len(suite.inner.slices, 2)
+
+	// a cached nil doesn't match the destination either
+	store.entries["posts:0:slice:0:3:[]int"] = []byte("null")
+	var items []int
+	require.NoError(a.Slice(0, 3, &items))
+	require.Equal([]int{1, 2, 3}, items)
+}
+
+func (suite *CacheAdapterTestSuite) TestTTL() {
+	a := adapter.NewCacheAdapter(suite.inner, adapter.CacheOptions{TTL: 20 * time.Millisecond})
+
+	require := suite.Require()
+	_, err := a.Nums()
+	require.NoError(err)
+	_, err = a.Nums()
+	require.NoError(err)
+	require.Equal(1, suite.inner.nums)
+
+	time.Sleep(30 * time.Millisecond)
+	_, err = a.Nums()
+	require.NoError(err)
+	require.Equal(2, suite.inner.nums)
+}
+
+func (suite *CacheAdapterTestSuite) TestSharedStore() {
+	store := adapter.NewMemoryCacheStore(2)
+	opts := adapter.CacheOptions{Store: store, Namespace: "posts"}
+
+	require := suite.Require()
+	var items []int
+	require.NoError(adapter.NewCacheAdapter(suite.inner, opts).Slice(0, 5, &items))
+	require.NoError(adapter.NewCacheAdapter(suite.inner, opts).Slice(0, 5, &items))
+	require.Len(suite.inner.slices, 1)
+
+	// the least recently used entry is evicted
+	a := adapter.NewCacheAdapter(suite.inner, opts)
+	require.NoError(a.Slice(5, 5, &items))
+	require.NoError(a.Slice(0, 5, &items))
+	require.NoError(a.Slice(10, 5, &items))
+	require.Equal(2, store.Len())
+	require.Len(suite.inner.slices, 3)
+
+	require.NoError(a.Slice(5, 5, &items))
+	require.Equal([]int{6, 7, 8, 9, 10}, items)
+	require.Len(suite.inner.slices, 4)
+}
+
+func (suite *CacheAdapterTestSuite) TestUnknownNums() {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	p := paginator.New(adapter.NewCacheAdapter(adapter.NewChanAdapter(ch), adapter.CacheOptions{}), 2)
+	p.SetPage(2)
+
+	require := suite.Require()
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{3}, items)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(2, pn)
+}
+
+func (suite *CacheAdapterTestSuite) TestErrors() {
+	a := adapter.NewCacheAdapter(adapter.NewSliceAdapter(nil), adapter.CacheOptions{})
+
+	require := suite.Require()
+	_, err := a.Nums()
+	require.EqualError(err, "expected slice but got nil")
+
+	var items []int
+	require.EqualError(a.Slice(0, 10, &items), "expected slice but got nil")
+	require.EqualError(a.Slice(0, 10, items), "expected to be a ptr but got []int")
+}
+
+func TestCacheAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheAdapterTestSuite))
+}
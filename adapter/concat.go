@@ -0,0 +1,133 @@
+package adapter
+
+import (
+	"errors"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+)
+
+var errConcatUnknownNums = errors.New("concat: only the last adapter can have an unknown number of items")
+
+var _ paginator.Peeker = (*ConcatAdapter)(nil)
+
+// ConcatAdapter adapter to be passed to paginator constructor to paginate several adapters chained end-to-end,
+// e.g. pinned items from a slice followed by database rows.
+type ConcatAdapter struct {
+	adapters []paginator.Adapter
+}
+
+// NewConcatAdapter concat adapter constructor receive the adapters to be chained, in order.
+// All of them must be able to fill the same destination type.
+// Only the last one can have an unknown number of items, in which case it must implement paginator.Peeker.
+func NewConcatAdapter(adapters ...paginator.Adapter) paginator.Adapter {
+	return &ConcatAdapter{adapters: adapters}
+}
+
+// Nums returns the sum of the number of items of the adapters,
+// or paginator.UnknownNums if the last adapter can't count its items
+func (a *ConcatAdapter) Nums() (int64, error) {
+	var total int64
+	for i, child := range a.adapters {
+		n, err := a.nums(i, child)
+		if err != nil {
+			return 0, err
+		}
+
+		if n == paginator.UnknownNums {
+			return paginator.UnknownNums, nil
+		}
+
+		total += n
+	}
+
+	return total, nil
+}
+
+// HasMore returns true if there is an item at offset
+func (a *ConcatAdapter) HasMore(offset int) (bool, error) {
+	var start int64
+	for i, child := range a.adapters {
+		n, err := a.nums(i, child)
+		if err != nil {
+			return false, err
+		}
+
+		if n == paginator.UnknownNums {
+			peeker, ok := child.(paginator.Peeker)
+			if !ok {
+				return false, paginator.ErrUnknownNums
+			}
+
+			return peeker.HasMore(int(int64(offset) - start))
+		}
+
+		if int64(offset) < start+n {
+			return true, nil
+		}
+
+		start += n
+	}
+
+	return false, nil
+}
+
+// Slice stores into dest argument a slice of the results.
+// Each adapter is asked only for its portion of the requested range.
+// dest argument must be a pointer to a slice
+func (a *ConcatAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, length); err != nil {
+		return err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	end := int64(offset + length)
+
+	var start int64
+	for i, child := range a.adapters {
+		if start >= end {
+			break
+		}
+
+		n, err := a.nums(i, child)
+		if err != nil {
+			return err
+		}
+
+		childEnd := start + n
+		if n == paginator.UnknownNums || childEnd > end {
+			childEnd = end
+		}
+
+		childOffset := int64(offset) - start
+		if childOffset < 0 {
+			childOffset = 0
+		}
+
+		if childLength := childEnd - start - childOffset; childLength > 0 {
+			part := reflect.New(vt.Type())
+			if err := child.Slice(int(childOffset), int(childLength), part.Interface()); err != nil {
+				return err
+			}
+
+			vt.Set(reflect.AppendSlice(vt, part.Elem()))
+		}
+
+		start += n
+	}
+
+	return nil
+}
+
+// nums returns the number of items of the i-th adapter
+func (a *ConcatAdapter) nums(i int, child paginator.Adapter) (int64, error) {
+	n, err := child.Nums()
+	if err != nil {
+		return 0, err
+	}
+
+	if n == paginator.UnknownNums && i != len(a.adapters)-1 {
+		return 0, errConcatUnknownNums
+	}
+
+	return n, nil
+}
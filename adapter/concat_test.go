@@ -0,0 +1,154 @@
+package adapter_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"testing"
+)
+
+type (
+	// spyAdapter records the Nums and Slice calls of the wrapped adapter
+	spyAdapter struct {
+		paginator.Adapter
+		nums   int
+		slices [][2]int
+	}
+
+	ConcatAdapterTestSuite struct {
+		suite.Suite
+		pinned *spyAdapter
+		rows   *spyAdapter
+	}
+)
+
+func (a *spyAdapter) Nums() (int64, error) {
+	a.nums++
+
+	return a.Adapter.Nums()
+}
+
+func (a *spyAdapter) Slice(offset, length int, dest interface{}) error {
+	a.slices = append(a.slices, [2]int{offset, length})
+
+	return a.Adapter.Slice(offset, length, dest)
+}
+
+func (suite *ConcatAdapterTestSuite) SetupTest() {
+	rows := make([]int, 100)
+	for i := range rows {
+		rows[i] = i + 1
+	}
+
+	suite.pinned = &spyAdapter{Adapter: adapter.NewSliceAdapter([]int{-3, -2, -1})}
+	suite.rows = &spyAdapter{Adapter: adapter.NewSliceAdapter(rows)}
+}
+
+func (suite *ConcatAdapterTestSuite) TestNums() {
+	require := suite.Require()
+	n, err := adapter.NewConcatAdapter(suite.pinned, suite.rows).Nums()
+	require.NoError(err)
+	require.EqualValues(103, n)
+
+	n, err = adapter.NewConcatAdapter().Nums()
+	require.NoError(err)
+	require.EqualValues(0, n)
+}
+
+func (suite *ConcatAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(adapter.NewConcatAdapter(suite.pinned, suite.rows), 10)
+
+	require := suite.Require()
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(11, pn)
+
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{-3, -2, -1, 1, 2, 3, 4, 5, 6, 7}, items)
+	require.Equal([][2]int{{0, 3}}, suite.pinned.slices)
+	require.Equal([][2]int{{0, 7}}, suite.rows.slices)
+}
+
+func (suite *ConcatAdapterTestSuite) TestBoundaries() {
+	a := adapter.NewConcatAdapter(suite.pinned, suite.rows)
+
+	require := suite.Require()
+	var items []int
+	require.NoError(a.Slice(1, 2, &items))
+	require.Equal([]int{-2, -1}, items)
+	require.Empty(suite.rows.slices)
+
+	require.NoError(a.Slice(3, 2, &items))
+	require.Equal([]int{1, 2}, items)
+	require.Equal([][2]int{{1, 2}}, suite.pinned.slices)
+	require.Equal([][2]int{{0, 2}}, suite.rows.slices)
+}
+
+func (suite *ConcatAdapterTestSuite) TestLastPage() {
+	p := paginator.New(adapter.NewConcatAdapter(suite.pinned, suite.rows), 10)
+	p.SetPage(11)
+
+	require := suite.Require()
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{98, 99, 100}, items)
+	require.Empty(suite.pinned.slices)
+	require.Equal([][2]int{{97, 3}}, suite.rows.slices)
+}
+
+func (suite *ConcatAdapterTestSuite) TestOffsetBeyondEnd() {
+	var items []int
+	suite.Require().NoError(adapter.NewConcatAdapter(suite.pinned, suite.rows).Slice(200, 10, &items))
+	suite.Require().Empty(items)
+}
+
+func (suite *ConcatAdapterTestSuite) TestUnknownNums() {
+	ch := make(chan int, 20)
+	for i := 1; i <= 20; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	p := paginator.New(adapter.NewConcatAdapter(suite.pinned, adapter.NewChanAdapter(ch)), 10)
+	p.SetPage(2)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.Equal(paginator.UnknownNums, n)
+
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, items)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(3, pn)
+
+	_, err = adapter.NewConcatAdapter(adapter.NewChanAdapter(ch), suite.rows).Nums()
+	require.EqualError(err, "concat: only the last adapter can have an unknown number of items")
+}
+
+func (suite *ConcatAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	var items []int
+	err := adapter.NewConcatAdapter(suite.pinned, suite.rows).Slice(0, 10, items)
+	require.EqualError(err, "expected to be a ptr but got []int")
+
+	failing := adapter.NewSliceAdapter(nil)
+	_, err = adapter.NewConcatAdapter(suite.pinned, failing).Nums()
+	require.EqualError(err, "expected slice but got nil")
+
+	err = adapter.NewConcatAdapter(suite.pinned, failing).Slice(0, 10, &items)
+	require.EqualError(err, "expected slice but got nil")
+
+	// the adapters past the requested range are not called
+	require.NoError(adapter.NewConcatAdapter(suite.pinned, failing).Slice(0, 3, &items))
+	require.Equal([]int{-3, -2, -1}, items)
+}
+
+func TestConcatAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(ConcatAdapterTestSuite))
+}
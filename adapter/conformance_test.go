@@ -0,0 +1,538 @@
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"github.com/vcraescu/go-paginator/v2/paginatortest"
+	bolt "go.etcd.io/bbolt"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestConformance(t *testing.T) {
+	posts := make([]Post, 25)
+	for i := range posts {
+		posts[i] = Post{ID: uint(i + 1), Number: i + 1}
+	}
+
+	lines := make([]string, len(posts))
+	for i, post := range posts {
+		lines[i] = fmt.Sprint(post.Number)
+	}
+
+	db, err := gorm.Open(sqlite.Open("file:conformance?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&Post{}))
+	require.NoError(t, db.Create(&posts).Error)
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	boltDB, err := bolt.Open(filepath.Join(dir, "conformance.db"), 0600, nil)
+	require.NoError(t, err)
+	defer boltDB.Close()
+
+	redisServer, err := miniredis.Run()
+	require.NoError(t, err)
+	defer redisServer.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	defer redisClient.Close()
+
+	server := httptest.NewServer(newConformanceServer(posts))
+	defer server.Close()
+
+	var closers []io.Closer
+	defer func() {
+		for _, c := range closers {
+			_ = c.Close()
+		}
+	}()
+
+	// fs entries are compared by name, the directory n-<n> holds the first n posts
+	fsys := fstest.MapFS{"n-0": {Mode: fs.ModeDir}}
+	names := make([]string, len(posts))
+	for i, post := range posts {
+		names[i] = fmt.Sprintf("post-%02d.json", post.Number)
+		fsys[fmt.Sprintf("n-%d/%s", len(posts), names[i])] = &fstest.MapFile{Data: []byte("{}")}
+	}
+
+	snapshots := adapter.NewMemoryCacheStore(0)
+
+	tests := []struct {
+		name       string
+		items      interface{}
+		newAdapter func(n int) (paginator.Adapter, error)
+		newFailing func() (paginator.Adapter, error)
+	}{
+		{
+			name:  "slice",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewSliceAdapter(posts[:n]), nil
+			},
+		},
+		{
+			name:  "zero-copy slice",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewZeroCopySliceAdapter(posts[:n]), nil
+			},
+		},
+		{
+			name:  "map",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				m := make(map[int]Post, n)
+				for _, post := range posts[:n] {
+					m[post.Number] = post
+				}
+
+				return adapter.NewMapAdapter(m), nil
+			},
+		},
+		{
+			name:  "chan",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				ch := make(chan Post, n)
+				for _, post := range posts[:n] {
+					ch <- post
+				}
+				close(ch)
+
+				return adapter.NewChanAdapter(ch), nil
+			},
+		},
+		{
+			name:  "file lines",
+			items: lines,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				path := filepath.Join(dir, fmt.Sprintf("lines-%d.txt", n))
+				data := strings.Join(lines[:n], "\n")
+
+				return adapter.NewFileLinesAdapter(path), ioutil.WriteFile(path, []byte(data), 0o600)
+			},
+		},
+		{
+			name:  "json array",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				data, err := json.Marshal(posts[:n])
+
+				return adapter.NewJSONArrayAdapter(bytes.NewReader(data)), err
+			},
+		},
+		{
+			name:  "csv",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				var b strings.Builder
+				b.WriteString("id,number\n")
+				for _, post := range posts[:n] {
+					fmt.Fprintf(&b, "%d,%d\n", post.ID, post.Number)
+				}
+
+				return adapter.NewCSVAdapter(strings.NewReader(b.String()), 0), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				return adapter.NewCSVFileAdapter(filepath.Join(dir, "missing.csv"), 0), nil
+			},
+		},
+		{
+			name:  "fs",
+			items: names,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				a := adapter.NewFSAdapter(fsys, fmt.Sprintf("n-%d", n), adapter.FSOptions{})
+
+				return adapter.NewTransformAdapter(a, func(d fs.DirEntry) string { return d.Name() }), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				a := adapter.NewFSAdapter(fsys, "missing", adapter.FSOptions{})
+
+				return adapter.NewTransformAdapter(a, func(d fs.DirEntry) string { return d.Name() }), nil
+			},
+		},
+		{
+			name:  "bolt",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				bucket := []byte(fmt.Sprintf("posts-%d", n))
+				err := boltDB.Update(func(tx *bolt.Tx) error {
+					b, err := tx.CreateBucketIfNotExists(bucket)
+					if err != nil {
+						return err
+					}
+
+					for _, post := range posts[:n] {
+						data, err := json.Marshal(post)
+						if err != nil {
+							return err
+						}
+
+						if err := b.Put([]byte(fmt.Sprintf("post:%03d", post.Number)), data); err != nil {
+							return err
+						}
+					}
+
+					return nil
+				})
+
+				return adapter.NewBoltAdapter(boltDB, bucket, adapter.BoltOptions{}), err
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				return adapter.NewBoltAdapter(boltDB, []byte("missing"), adapter.BoltOptions{}), nil
+			},
+		},
+		{
+			name:  "redis zset",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				key := fmt.Sprintf("posts-zset-%d", n)
+				for _, post := range posts[:n] {
+					data, err := json.Marshal(post)
+					if err != nil {
+						return nil, err
+					}
+
+					if _, err := redisServer.ZAdd(key, float64(post.Number), string(data)); err != nil {
+						return nil, err
+					}
+				}
+
+				return adapter.NewRedisZSetAdapter(ctx, redisClient, key, adapter.RedisZSetOptions{}), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				return adapter.NewRedisZSetAdapter(ctx, closedRedisClient(), "posts", adapter.RedisZSetOptions{}), nil
+			},
+		},
+		{
+			name:  "redis list",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				key := fmt.Sprintf("posts-list-%d", n)
+				for _, post := range posts[:n] {
+					data, err := json.Marshal(post)
+					if err != nil {
+						return nil, err
+					}
+
+					if _, err := redisServer.Push(key, string(data)); err != nil {
+						return nil, err
+					}
+				}
+
+				return adapter.NewRedisListAdapter(ctx, redisClient, key, adapter.RedisListOptions{}), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				return adapter.NewRedisListAdapter(ctx, closedRedisClient(), "posts", adapter.RedisListOptions{}), nil
+			},
+		},
+		{
+			name:  "elasticsearch",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				es := httptest.NewServer(&esServer{nums: n, window: 50, trackTotalHits: 10000})
+				closers = append(closers, closerFunc(es.Close))
+
+				return adapter.NewElasticsearchAdapter(ctx, adapter.ElasticsearchOptions{
+					URL:             es.URL,
+					Index:           "posts",
+					Sort:            []interface{}{map[string]string{"number": "asc"}},
+					MaxResultWindow: 50,
+				}), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				return adapter.NewElasticsearchAdapter(ctx, adapter.ElasticsearchOptions{
+					URL:   server.URL,
+					Index: "missing",
+				}), nil
+			},
+		},
+		{
+			name:  "rest pages",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewRESTAdapter(ctx, adapter.RESTOptions{
+					URL:          fmt.Sprintf("%s/rest?n=%d", server.URL, n),
+					PageParam:    "page",
+					PerPageParam: "per_page",
+					PageSize:     7,
+					TotalPath:    "total",
+					ItemsPath:    "items",
+				}), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				return adapter.NewRESTAdapter(ctx, adapter.RESTOptions{URL: server.URL + "/missing", PageParam: "page"}), nil
+			},
+		},
+		{
+			name:  "rest offsets",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewRESTAdapter(ctx, adapter.RESTOptions{
+					URL:         fmt.Sprintf("%s/rest?n=%d", server.URL, n),
+					OffsetParam: "offset",
+					LimitParam:  "limit",
+					PageSize:    7,
+					TotalPath:   "total",
+					ItemsPath:   "items",
+				}), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				return adapter.NewRESTAdapter(ctx, adapter.RESTOptions{URL: server.URL + "/missing", OffsetParam: "offset"}), nil
+			},
+		},
+		{
+			name:  "link",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewLinkAdapter(ctx, fmt.Sprintf("%s/link?n=%d", server.URL, n), adapter.LinkOptions{
+					ItemsPath: "items",
+				}), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				return adapter.NewLinkAdapter(ctx, server.URL+"/missing", adapter.LinkOptions{}), nil
+			},
+		},
+		{
+			name:  "iterator",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				a := adapter.NewIteratorAdapter(func(yield func(Post) bool) {
+					for _, post := range posts[:n] {
+						if !yield(post) {
+							return
+						}
+					}
+				})
+				closers = append(closers, a.(io.Closer))
+
+				return a, nil
+			},
+		},
+		{
+			name:  "snapshot",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewSnapshotAdapter(func() (interface{}, error) {
+					ids := make([]uint, n)
+					for i, post := range posts[:n] {
+						ids[i] = post.ID
+					}
+
+					return ids, nil
+				}, func(ids interface{}) paginator.Adapter {
+					page := make([]Post, 0)
+					for _, id := range ids.([]uint) {
+						page = append(page, posts[id-1])
+					}
+
+					return adapter.NewSliceAdapter(page)
+				}, adapter.SnapshotOptions{Namespace: "posts", Store: snapshots, TTL: time.Minute}), nil
+			},
+		},
+		{
+			name:  "gorm",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewGORMAdapter(db.Model(Post{}).Where("number <= ?", n).Order("number")), nil
+			},
+			newFailing: func() (paginator.Adapter, error) {
+				closed, err := gorm.Open(sqlite.Open("file:closed?mode=memory"), &gorm.Config{})
+				if err != nil {
+					return nil, err
+				}
+
+				sqlDB, err := closed.DB()
+				if err != nil {
+					return nil, err
+				}
+
+				return adapter.NewGORMAdapter(closed.Model(Post{})), sqlDB.Close()
+			},
+		},
+		{
+			name:  "document",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				coll := adapter.NewMemoryCollection()
+				for _, post := range posts[:n] {
+					if err := coll.Insert(post); err != nil {
+						return nil, err
+					}
+				}
+
+				return adapter.NewDocumentAdapter(context.Background(), coll, nil, adapter.DocumentSort{Field: "Number"}), nil
+			},
+		},
+		{
+			name:  "concat",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				split := n / 3
+
+				return adapter.NewConcatAdapter(
+					adapter.NewSliceAdapter(posts[:split]),
+					adapter.NewSliceAdapter([]Post{}),
+					adapter.NewSliceAdapter(posts[split:n]),
+				), nil
+			},
+		},
+		{
+			name:  "merge",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				var odd, even []Post
+				for _, post := range posts[:n] {
+					if post.Number%2 == 0 {
+						even = append(even, post)
+					} else {
+						odd = append(odd, post)
+					}
+				}
+
+				return adapter.NewMergeAdapter(func(a, b interface{}) bool {
+					return a.(Post).Number < b.(Post).Number
+				}, adapter.NewSliceAdapter(even), adapter.NewSliceAdapter(odd)), nil
+			},
+		},
+		{
+			name:  "dedup",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				inner := adapter.NewConcatAdapter(adapter.NewSliceAdapter(posts[:n]), adapter.NewSliceAdapter(posts[:n/2]))
+
+				return adapter.NewDedupAdapter(inner, func(p Post) uint { return p.ID }), nil
+			},
+		},
+		{
+			name:  "filter",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				all := make([]Post, 0, 2*n)
+				for _, post := range posts[:n] {
+					all = append(all, post, Post{Number: -post.Number})
+				}
+
+				return adapter.NewFilterAdapter(adapter.NewSliceAdapter(all), func(p Post) bool {
+					return p.Number > 0
+				}), nil
+			},
+		},
+		{
+			name:  "transform",
+			items: lines,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewTransformAdapter(adapter.NewSliceAdapter(posts[:n]), func(p Post) string {
+					return fmt.Sprint(p.Number)
+				}), nil
+			},
+		},
+		{
+			name:  "cache",
+			items: posts,
+			newAdapter: func(n int) (paginator.Adapter, error) {
+				return adapter.NewCacheAdapter(adapter.NewSliceAdapter(posts[:n]), adapter.CacheOptions{}), nil
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.NoError(t, paginatortest.TestAdapter(paginatortest.Config{
+				New:        test.newAdapter,
+				Items:      test.items,
+				NewFailing: test.newFailing,
+			}))
+		})
+	}
+}
+
+// newConformanceServer returns the handler of an upstream service listing the first n posts, n being a query parameter:
+// on /rest by page number or offset with the total in the body, on /link following the next links.
+// Any other path fails.
+func newConformanceServer(posts []Post) http.Handler {
+	page := func(r *http.Request, from, size int) []Post {
+		n, _ := strconv.Atoi(r.URL.Query().Get("n"))
+		items := make([]Post, 0)
+		for i := from; i >= 0 && i < from+size && i < n; i++ {
+			items = append(items, posts[i])
+		}
+
+		return items
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		n, _ := strconv.Atoi(q.Get("n"))
+
+		var from, size int
+		if q.Get("page") != "" {
+			p, _ := strconv.Atoi(q.Get("page"))
+			size, _ = strconv.Atoi(q.Get("per_page"))
+			from = (p - 1) * size
+		} else {
+			from, _ = strconv.Atoi(q.Get("offset"))
+			size, _ = strconv.Atoi(q.Get("limit"))
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"total": n, "items": page(r, from, size)})
+	})
+	mux.HandleFunc("/link", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		n, _ := strconv.Atoi(q.Get("n"))
+		from, _ := strconv.Atoi(q.Get("cursor"))
+		if from+4 < n {
+			w.Header().Set("Link", fmt.Sprintf(`<?n=%d&cursor=%d>; rel="next"`, n, from+4))
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": page(r, from, 4)})
+	})
+
+	return mux
+}
+
+// closedRedisClient returns a client of a redis server which is no longer running
+func closedRedisClient() *redis.Client {
+	server := miniredis.NewMiniRedis()
+	if err := server.Start(); err != nil {
+		panic(err)
+	}
+
+	addr := server.Addr()
+	server.Close()
+
+	return redis.NewClient(&redis.Options{Addr: addr, MaxRetries: -1})
+}
+
+type closerFunc func()
+
+func (f closerFunc) Close() error {
+	f()
+
+	return nil
+}
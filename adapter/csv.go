@@ -0,0 +1,325 @@
+package adapter
+
+import (
+	"bufio"
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	recordType          = reflect.TypeOf([]string(nil))
+	recordMapType       = reflect.TypeOf(map[string]string(nil))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// CSVAdapter adapter to paginate the rows of a CSV file.
+// The first row is the header. The first call builds an index with the offset of every row,
+// so Slice seeks directly to the first row of the page.
+//
+// The rows can be stored into a slice of []string, a slice of map[string]string keyed by the header
+// or a slice of structs. The struct fields are mapped to the header columns by the csv tag, `csv:"name"`,
+// or by their name, case insensitive. Fields tagged with `csv:"-"` are skipped.
+type CSVAdapter struct {
+	open  func() (io.ReadSeeker, func() error, error)
+	comma rune
+
+	mu     sync.Mutex
+	header []string
+	index  []int64
+}
+
+// countingReader counts the bytes read from the underlying reader
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+
+	return n, err
+}
+
+// NewCSVAdapter csv adapter constructor receive the reader of the CSV data and the fields delimiter.
+// A zero comma means the default ',' delimiter.
+func NewCSVAdapter(r io.ReadSeeker, comma rune) paginator.Adapter {
+	return &CSVAdapter{
+		open: func() (io.ReadSeeker, func() error, error) {
+			return r, func() error { return nil }, nil
+		},
+		comma: comma,
+	}
+}
+
+// NewCSVFileAdapter csv adapter constructor receive the path of the CSV file and the fields delimiter.
+// A zero comma means the default ',' delimiter.
+func NewCSVFileAdapter(path string, comma rune) paginator.Adapter {
+	return &CSVAdapter{
+		open: func() (io.ReadSeeker, func() error, error) {
+			f, err := os.Open(path)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return f, f.Close, nil
+		},
+		comma: comma,
+	}
+}
+
+// Nums returns the number of rows, without the header
+func (a *CSVAdapter) Nums() (int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.buildIndex(); err != nil {
+		return 0, err
+	}
+
+	return int64(len(a.index)), nil
+}
+
+// Slice stores into dest argument a slice of the rows.
+// dest argument must be a pointer to a slice of []string, map[string]string or structs.
+func (a *CSVAdapter) Slice(offset, length int, dest interface{}) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.buildIndex(); err != nil {
+		return err
+	}
+
+	if offset > len(a.index) {
+		offset = len(a.index)
+	}
+
+	if len(a.index) < offset+length {
+		length = len(a.index) - offset
+	}
+
+	if err := makeSlice(dest, length, length); err != nil {
+		return err
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	decode, err := a.decoder(vt.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	rs, closeFn, err := a.open()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	if _, err := rs.Seek(a.index[offset], io.SeekStart); err != nil {
+		return err
+	}
+
+	r := a.reader(rs)
+	for i := 0; i < length; i++ {
+		record, err := r.Read()
+		if err != nil {
+			return err
+		}
+
+		if err := decode(record, vt.Index(i)); err != nil {
+			return fmt.Errorf("csv: row %d: %w", offset+i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *CSVAdapter) reader(r io.Reader) *csv.Reader {
+	cr := csv.NewReader(r)
+	if a.comma != 0 {
+		cr.Comma = a.comma
+	}
+
+	cr.FieldsPerRecord = -1
+
+	return cr
+}
+
+// buildIndex reads the header and the offset of every row on first call
+func (a *CSVAdapter) buildIndex() error {
+	if a.index != nil {
+		return nil
+	}
+
+	rs, closeFn, err := a.open()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	// csv.Reader reads through the buffered reader as is, so the offset of the next row is
+	// the number of bytes read minus the ones still buffered
+	cr := &countingReader{r: rs}
+	br := bufio.NewReader(cr)
+	r := a.reader(br)
+	header, err := r.Read()
+	if err == io.EOF {
+		a.header, a.index = []string{}, []int64{}
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	index := make([]int64, 0)
+	for {
+		offset := cr.n - int64(br.Buffered())
+		if _, err := r.Read(); err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return err
+		}
+
+		index = append(index, offset)
+	}
+
+	a.header, a.index = header, index
+
+	return nil
+}
+
+// decoder returns the function which stores a record into a value of type t
+func (a *CSVAdapter) decoder(t reflect.Type) (func(record []string, v reflect.Value) error, error) {
+	switch {
+	case t == recordType:
+		return func(record []string, v reflect.Value) error {
+			v.Set(reflect.ValueOf(record))
+
+			return nil
+		}, nil
+	case t == recordMapType:
+		return func(record []string, v reflect.Value) error {
+			m := make(map[string]string, len(a.header))
+			for i, name := range a.header {
+				if i < len(record) {
+					m[name] = record[i]
+				}
+			}
+
+			v.Set(reflect.ValueOf(m))
+
+			return nil
+		}, nil
+	case t.Kind() == reflect.Struct:
+		columns := a.columns(t)
+
+		return func(record []string, v reflect.Value) error {
+			for i, field := range columns {
+				if field < 0 || i >= len(record) {
+					continue
+				}
+
+				if err := setField(v.Field(field), record[i]); err != nil {
+					return fmt.Errorf("column %q: %w", a.header[i], err)
+				}
+			}
+
+			return nil
+		}, nil
+	}
+
+	return nil, fmt.Errorf("csv: unsupported destination %s", t)
+}
+
+// columns maps every header column to the index of the struct field, or -1 if there is no such field
+func (a *CSVAdapter) columns(t reflect.Type) []int {
+	columns := make([]int, len(a.header))
+	for i, name := range a.header {
+		columns[i] = -1
+		for j := 0; j < t.NumField(); j++ {
+			f := t.Field(j)
+			if f.PkgPath != "" {
+				continue
+			}
+
+			tag := f.Tag.Get("csv")
+			if tag == "-" {
+				continue
+			}
+
+			if tag == name || (tag == "" && strings.EqualFold(f.Name, name)) {
+				columns[i] = j
+				break
+			}
+		}
+	}
+
+	return columns
+}
+
+func setField(v reflect.Value, s string) error {
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	// empty cells leave the zero value
+	if s == "" {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+
+	return nil
+}
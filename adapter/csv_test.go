@@ -0,0 +1,162 @@
+package adapter_test
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type (
+	CSVPost struct {
+		ID     uint   `csv:"id"`
+		Title  string `csv:"post title"`
+		Number int
+		Score  float64 `csv:"-"`
+	}
+
+	CSVAdapterTestSuite struct {
+		suite.Suite
+		path string
+	}
+)
+
+func (suite *CSVAdapterTestSuite) SetupTest() {
+	var b strings.Builder
+	b.WriteString("id,post title,number,score\n")
+	for i := 1; i <= 100; i++ {
+		fmt.Fprintf(&b, "%d,\"Post %d, \"\"quoted\"\"\nsecond line\",%d,1.5\n", i, i, i)
+	}
+
+	suite.path = filepath.Join(suite.T().TempDir(), "posts.csv")
+	suite.Require().NoError(ioutil.WriteFile(suite.path, []byte(b.String()), 0644))
+}
+
+func (suite *CSVAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(adapter.NewCSVFileAdapter(suite.path, 0), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	hn, err := p.HasNext()
+	require.NoError(err)
+	require.True(hn)
+}
+
+func (suite *CSVAdapterTestSuite) TestStructResults() {
+	p := paginator.New(adapter.NewCSVFileAdapter(suite.path, 0), 10)
+
+	require := suite.Require()
+	var posts []CSVPost
+	p.SetPage(5)
+
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		n := 41 + i
+		require.Equal(CSVPost{
+			ID:     uint(n),
+			Title:  fmt.Sprintf("Post %d, \"quoted\"\nsecond line", n),
+			Number: n,
+		}, post)
+	}
+}
+
+func (suite *CSVAdapterTestSuite) TestRecordResults() {
+	a := adapter.NewCSVFileAdapter(suite.path, 0)
+
+	require := suite.Require()
+	var records [][]string
+	require.NoError(a.Slice(99, 10, &records))
+	require.Equal([][]string{{"100", "Post 100, \"quoted\"\nsecond line", "100", "1.5"}}, records)
+
+	var rows []map[string]string
+	require.NoError(a.Slice(0, 1, &rows))
+	require.Equal([]map[string]string{{
+		"id":         "1",
+		"post title": "Post 1, \"quoted\"\nsecond line",
+		"number":     "1",
+		"score":      "1.5",
+	}}, rows)
+}
+
+func (suite *CSVAdapterTestSuite) TestReaderWithDelimiter() {
+	r := strings.NewReader("id;post title;number\n1;first;10\n2;\"semi;colon\";20\n3;third;\n")
+	p := paginator.New(adapter.NewCSVAdapter(r, ';'), 2)
+	p.SetPage(2)
+
+	require := suite.Require()
+	var posts []CSVPost
+	require.NoError(p.Results(&posts))
+	require.Equal([]CSVPost{{ID: 3, Title: "third"}}, posts)
+
+	p.SetPage(1)
+	require.NoError(p.Results(&posts))
+	require.Equal([]CSVPost{{ID: 1, Title: "first", Number: 10}, {ID: 2, Title: "semi;colon", Number: 20}}, posts)
+}
+
+func (suite *CSVAdapterTestSuite) TestRowOffsets() {
+	// CRLF line endings, blank lines and a last row without a line ending
+	r := strings.NewReader("id,number\r\n1,10\r\n\r\n2,20\r\n3,30")
+	a := adapter.NewCSVAdapter(r, 0)
+
+	require := suite.Require()
+	n, err := a.Nums()
+	require.NoError(err)
+	require.EqualValues(3, n)
+
+	var records [][]string
+	for i, want := range [][]string{{"1", "10"}, {"2", "20"}, {"3", "30"}} {
+		require.NoError(a.Slice(i, 1, &records))
+		require.Equal([][]string{want}, records)
+	}
+}
+
+func (suite *CSVAdapterTestSuite) TestEmpty() {
+	require := suite.Require()
+	for _, data := range []string{"", "id,number\n"} {
+		p := paginator.New(adapter.NewCSVAdapter(strings.NewReader(data), 0), 10)
+
+		n, err := p.Nums()
+		require.NoError(err)
+		require.EqualValues(0, n)
+
+		var posts []CSVPost
+		require.NoError(p.Results(&posts))
+		require.Empty(posts)
+	}
+}
+
+func (suite *CSVAdapterTestSuite) TestInvalidValue() {
+	r := strings.NewReader("id,number\n1,one\n")
+
+	var posts []CSVPost
+	err := adapter.NewCSVAdapter(r, 0).Slice(0, 10, &posts)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), `csv: row 1: column "number"`)
+}
+
+func (suite *CSVAdapterTestSuite) TestUnsupportedDestination() {
+	var numbers []int
+	err := adapter.NewCSVFileAdapter(suite.path, 0).Slice(0, 10, &numbers)
+	suite.Require().EqualError(err, "csv: unsupported destination int")
+}
+
+func (suite *CSVAdapterTestSuite) TestMissingFile() {
+	_, err := adapter.NewCSVFileAdapter(filepath.Join(suite.T().TempDir(), "missing.csv"), 0).Nums()
+	suite.Require().Error(err)
+}
+
+func TestCSVAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(CSVAdapterTestSuite))
+}
@@ -0,0 +1,78 @@
+package adapter
+
+import (
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+)
+
+var _ paginator.Peeker = (*DedupAdapter)(nil)
+
+// DedupAdapter adapter wrapper removing the duplicate items of another adapter, e.g. a concat or merge adapter.
+// Only the first item having a given key is kept. The inner adapter is scanned lazily, only as far as needed to serve
+// the requested page, and the offsets of the kept items are remembered, so the page boundaries are stable and no item
+// appears on two pages. The total number of items is unknown, so Nums returns paginator.UnknownNums.
+type DedupAdapter struct {
+	index *filterIndex
+	err   error
+}
+
+// NewDedupAdapter dedup adapter constructor receive the inner adapter and the key function of its items,
+// having the func(T) K signature where K is a comparable type. The inner adapter is read into slices of T.
+func NewDedupAdapter(adapter paginator.Adapter, key interface{}) paginator.Adapter {
+	v := reflect.ValueOf(key)
+	if v.Kind() != reflect.Func || v.IsNil() || v.Type().IsVariadic() || v.Type().NumIn() != 1 ||
+		v.Type().NumOut() != 1 {
+		return &DedupAdapter{err: fmt.Errorf("dedup: expected key func(T) K but got %T", key)}
+	}
+
+	if !v.Type().Out(0).Comparable() {
+		return &DedupAdapter{err: fmt.Errorf("dedup: key type %s is not comparable", v.Type().Out(0))}
+	}
+
+	seen := make(map[interface{}]struct{})
+
+	return &DedupAdapter{index: &filterIndex{
+		adapter:  adapter,
+		elemType: v.Type().In(0),
+		prefix:   "dedup",
+		keep: func(item reflect.Value) (bool, error) {
+			k := v.Call([]reflect.Value{item})[0].Interface()
+			if _, ok := seen[k]; ok {
+				return false, nil
+			}
+
+			seen[k] = struct{}{}
+
+			return true, nil
+		},
+	}}
+}
+
+// Nums returns paginator.UnknownNums
+func (a *DedupAdapter) Nums() (int64, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+
+	return paginator.UnknownNums, nil
+}
+
+// HasMore returns true if there is an item at offset
+func (a *DedupAdapter) HasMore(offset int) (bool, error) {
+	if a.err != nil {
+		return false, a.err
+	}
+
+	return a.index.hasMore(offset)
+}
+
+// Slice stores into dest argument a slice of the unique items.
+// dest argument must be a pointer to a slice
+func (a *DedupAdapter) Slice(offset, length int, dest interface{}) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	return a.index.slice(offset, length, dest)
+}
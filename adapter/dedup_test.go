@@ -0,0 +1,151 @@
+package adapter_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"testing"
+)
+
+type DedupAdapterTestSuite struct {
+	suite.Suite
+	inner *spyAdapter
+}
+
+// SetupTest creates an adapter of the posts 1 to 20 followed by the posts 11 to 30, so the posts 11 to 20 are duplicated
+func (suite *DedupAdapterTestSuite) SetupTest() {
+	var first, second []Post
+	for i := 1; i <= 20; i++ {
+		first = append(first, Post{ID: uint(i), Number: i})
+		second = append(second, Post{ID: uint(i + 10), Number: i + 10})
+	}
+
+	suite.inner = &spyAdapter{Adapter: adapter.NewConcatAdapter(
+		adapter.NewSliceAdapter(first),
+		adapter.NewSliceAdapter(second),
+	)}
+}
+
+func (suite *DedupAdapterTestSuite) newAdapter() paginator.Adapter {
+	return adapter.NewDedupAdapter(suite.inner, func(p Post) uint {
+		return p.ID
+	})
+}
+
+func (suite *DedupAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(suite.newAdapter(), 8)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.Equal(paginator.UnknownNums, n)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 8)
+	require.Equal(Post{ID: 1, Number: 1}, posts[0])
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(2, pn)
+}
+
+func (suite *DedupAdapterTestSuite) TestAllPages() {
+	p := paginator.New(suite.newAdapter(), 8)
+
+	require := suite.Require()
+	var ids []uint
+	for page := 1; ; page++ {
+		p.SetPage(page)
+
+		var posts []Post
+		require.NoError(p.Results(&posts))
+		for _, post := range posts {
+			ids = append(ids, post.ID)
+		}
+
+		hasNext, err := p.HasNext()
+		require.NoError(err)
+		if !hasNext {
+			require.Equal(4, page)
+			require.Len(posts, 6)
+			break
+		}
+
+		require.Len(posts, 8)
+	}
+
+	// every post appears exactly once and in order
+	require.Len(ids, 30)
+	for i, id := range ids {
+		require.EqualValues(i+1, id)
+	}
+}
+
+func (suite *DedupAdapterTestSuite) TestStableBoundaries() {
+	a := suite.newAdapter()
+
+	require := suite.Require()
+	var posts []Post
+	require.NoError(a.Slice(16, 8, &posts))
+	require.Len(posts, 8)
+	require.Equal(Post{ID: 17, Number: 17}, posts[0])
+	require.Equal(Post{ID: 24, Number: 24}, posts[7])
+
+	// the earlier page is served from the remembered offsets and ends right before the later one
+	suite.inner.slices = nil
+	require.NoError(a.Slice(8, 8, &posts))
+	require.Equal(Post{ID: 9, Number: 9}, posts[0])
+	require.Equal(Post{ID: 16, Number: 16}, posts[7])
+	require.Equal([][2]int{{8, 8}}, suite.inner.slices)
+
+	require.NoError(a.Slice(24, 8, &posts))
+	require.Len(posts, 6)
+	require.Equal(Post{ID: 25, Number: 25}, posts[0])
+}
+
+func (suite *DedupAdapterTestSuite) TestOffsetBeyondEnd() {
+	var posts []Post
+	suite.Require().NoError(suite.newAdapter().Slice(100, 10, &posts))
+	suite.Require().Empty(posts)
+}
+
+func (suite *DedupAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	var posts []Post
+	err := adapter.NewDedupAdapter(suite.inner, "id").Slice(0, 10, &posts)
+	require.EqualError(err, "dedup: expected key func(T) K but got string")
+
+	_, err = adapter.NewDedupAdapter(suite.inner, func(p Post) []uint { return nil }).Nums()
+	require.EqualError(err, "dedup: key type []uint is not comparable")
+
+	err = suite.newAdapter().Slice(0, 10, posts)
+	require.EqualError(err, "expected to be a ptr but got []adapter_test.Post")
+
+	err = adapter.NewDedupAdapter(adapter.NewSliceAdapter(nil), func(p Post) uint { return p.ID }).Slice(0, 10, &posts)
+	require.EqualError(err, "expected slice but got nil")
+
+	_, err = adapter.NewDedupAdapter(suite.inner, func(p ...Post) uint { return 0 }).Nums()
+	require.EqualError(err, "dedup: expected key func(T) K but got func(...adapter_test.Post) uint")
+}
+
+func (suite *DedupAdapterTestSuite) TestInnerChanged() {
+	items := []Post{{ID: 1}, {ID: 1}, {ID: 2}, {ID: 3}}
+	a := adapter.NewDedupAdapter(adapter.NewSliceAdapter(&items), func(p Post) uint {
+		return p.ID
+	})
+
+	require := suite.Require()
+	var posts []Post
+	require.NoError(a.Slice(0, 3, &posts))
+	require.Equal([]Post{{ID: 1}, {ID: 2}, {ID: 3}}, posts)
+
+	// the remembered offsets point past the end of the inner items
+	items = items[:2]
+	require.EqualError(a.Slice(0, 3, &posts), "dedup: the items of the inner adapter changed")
+}
+
+func TestDedupAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(DedupAdapterTestSuite))
+}
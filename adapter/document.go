@@ -0,0 +1,263 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type (
+	// DocumentSort a field to sort the documents by
+	DocumentSort struct {
+		Field string
+		Desc  bool
+	}
+
+	// FindOptions options of a document query
+	FindOptions struct {
+		Sort  []DocumentSort
+		Skip  int64
+		Limit int64
+	}
+
+	// DocumentCollection must be implemented by the wrappers of document store drivers, like MongoDB, so they can be
+	// paginated with DocumentAdapter. The filter is passed as is, so it can be any type the driver understands.
+	DocumentCollection interface {
+		// CountDocuments returns the number of documents matching the filter
+		CountDocuments(ctx context.Context, filter interface{}) (int64, error)
+		// Find stores into dest, a pointer to a slice, the documents matching the filter
+		Find(ctx context.Context, filter interface{}, opts FindOptions, dest interface{}) error
+	}
+
+	// DocumentAdapter adapter to be passed to paginator constructor to paginate the documents of a collection.
+	DocumentAdapter struct {
+		ctx    context.Context
+		coll   DocumentCollection
+		filter interface{}
+		sort   []DocumentSort
+	}
+
+	// MemoryCollection in-memory DocumentCollection, the reference implementation used in tests.
+	// The documents are stored as maps and decoded into the destination through JSON.
+	// The filter must be a map[string]interface{} matching documents having equal fields;
+	// nested fields are referenced with dots, e.g. "author.name".
+	MemoryCollection struct {
+		mu   sync.RWMutex
+		docs []map[string]interface{}
+	}
+)
+
+// NewDocumentAdapter document adapter constructor receive the collection, the filter and the sort order of the documents.
+func NewDocumentAdapter(ctx context.Context, coll DocumentCollection, filter interface{}, sort ...DocumentSort) paginator.Adapter {
+	return &DocumentAdapter{ctx: ctx, coll: coll, filter: filter, sort: sort}
+}
+
+// Nums returns the number of documents matching the filter
+func (a *DocumentAdapter) Nums() (int64, error) {
+	return a.coll.CountDocuments(a.ctx, a.filter)
+}
+
+// Slice stores into dest argument a slice of the documents.
+// dest argument must be a pointer to a slice
+func (a *DocumentAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	// a zero limit means no limit for the document stores
+	if length <= 0 {
+		return nil
+	}
+
+	return a.coll.Find(a.ctx, a.filter, FindOptions{
+		Sort:  a.sort,
+		Skip:  int64(offset),
+		Limit: int64(length),
+	}, dest)
+}
+
+// NewMemoryCollection in-memory collection constructor
+func NewMemoryCollection() *MemoryCollection {
+	return &MemoryCollection{}
+}
+
+// Insert adds the documents to the collection. Any value which can be encoded to a JSON object is a valid document.
+func (c *MemoryCollection) Insert(docs ...interface{}) error {
+	maps := make([]map[string]interface{}, len(docs))
+	for i, doc := range docs {
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+
+		if err := json.Unmarshal(data, &maps[i]); err != nil {
+			return fmt.Errorf("document %d: %w", i, err)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.docs = append(c.docs, maps...)
+
+	return nil
+}
+
+// CountDocuments returns the number of documents matching the filter
+func (c *MemoryCollection) CountDocuments(ctx context.Context, filter interface{}) (int64, error) {
+	docs, err := c.match(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(docs)), nil
+}
+
+// Find stores into dest, a pointer to a slice, the documents matching the filter
+func (c *MemoryCollection) Find(ctx context.Context, filter interface{}, opts FindOptions, dest interface{}) error {
+	if !isPtr(dest) || !isSlice(dest) {
+		return fmt.Errorf("expected to be a slice pointer but got %T", dest)
+	}
+
+	docs, err := c.match(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(docs, func(i, j int) bool {
+		for _, s := range opts.Sort {
+			cmp := compareValues(lookup(docs[i], s.Field), lookup(docs[j], s.Field))
+			if cmp == 0 {
+				continue
+			}
+
+			return (cmp < 0) != s.Desc
+		}
+
+		return false
+	})
+
+	if opts.Skip > int64(len(docs)) {
+		opts.Skip = int64(len(docs))
+	}
+
+	docs = docs[opts.Skip:]
+	if opts.Limit > 0 && opts.Limit < int64(len(docs)) {
+		docs = docs[:opts.Limit]
+	}
+
+	data, err := json.Marshal(docs)
+	if err != nil {
+		return err
+	}
+
+	// reset dest so the decoded documents don't merge into the previous elements
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dest)
+}
+
+// match returns the documents matching the filter
+func (c *MemoryCollection) match(ctx context.Context, filter interface{}) ([]map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if filter != nil {
+		var ok bool
+		if fields, ok = filter.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("expected map[string]interface{} filter but got %T", filter)
+		}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	docs := make([]map[string]interface{}, 0, len(c.docs))
+	for _, doc := range c.docs {
+		matches := true
+		for field, value := range fields {
+			if compareValues(lookup(doc, field), value) != 0 {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			docs = append(docs, doc)
+		}
+	}
+
+	return docs, nil
+}
+
+// lookup returns the value of a dotted path field
+func lookup(doc map[string]interface{}, field string) interface{} {
+	var value interface{} = doc
+	for _, name := range strings.Split(field, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		value = m[name]
+	}
+
+	return value
+}
+
+// compareValues compares numbers of any type and strings by value, any other values by their JSON encoding
+func compareValues(a, b interface{}) int {
+	fa, aNum := toFloat(a)
+	fb, bNum := toFloat(b)
+	switch {
+	case aNum && bNum:
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+
+		return 0
+	case aNum != bNum:
+		// numbers go before any other value
+		if aNum {
+			return -1
+		}
+
+		return 1
+	}
+
+	sa, aStr := a.(string)
+	sb, bStr := b.(string)
+	if !aStr || !bStr {
+		da, _ := json.Marshal(a)
+		db, _ := json.Marshal(b)
+		sa, sb = string(da), string(db)
+	}
+
+	return strings.Compare(sa, sb)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,141 @@
+package adapter_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"testing"
+)
+
+var _ adapter.DocumentCollection = (*adapter.MemoryCollection)(nil)
+
+type (
+	Author struct {
+		Name string `json:"name"`
+	}
+
+	Article struct {
+		Number int    `json:"number"`
+		Status string `json:"status"`
+		Author Author `json:"author"`
+	}
+
+	DocumentAdapterTestSuite struct {
+		suite.Suite
+		coll *adapter.MemoryCollection
+		ctx  context.Context
+	}
+)
+
+func (suite *DocumentAdapterTestSuite) SetupTest() {
+	suite.coll = adapter.NewMemoryCollection()
+	suite.ctx = context.Background()
+
+	for i := 1; i <= 100; i++ {
+		status := "draft"
+		if i%2 == 0 {
+			status = "published"
+		}
+
+		author := "bob"
+		if i > 50 {
+			author = "alice"
+		}
+
+		suite.Require().NoError(suite.coll.Insert(Article{Number: i, Status: status, Author: Author{Name: author}}))
+	}
+}
+
+func (suite *DocumentAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(adapter.NewDocumentAdapter(suite.ctx, suite.coll, nil), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	var articles []Article
+	require.NoError(p.Results(&articles))
+	require.Len(articles, 10)
+	require.Equal(Article{Number: 1, Status: "draft", Author: Author{Name: "bob"}}, articles[0])
+}
+
+func (suite *DocumentAdapterTestSuite) TestFilterAndSort() {
+	filter := map[string]interface{}{"status": "published"}
+	p := paginator.New(adapter.NewDocumentAdapter(suite.ctx, suite.coll, filter, adapter.DocumentSort{
+		Field: "number",
+		Desc:  true,
+	}), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(50, n)
+
+	var articles []Article
+	p.SetPage(5)
+	require.NoError(p.Results(&articles))
+	require.Len(articles, 10)
+	for i, article := range articles {
+		require.Equal(20-i*2, article.Number)
+	}
+}
+
+func (suite *DocumentAdapterTestSuite) TestNestedFields() {
+	filter := map[string]interface{}{"author.name": "alice", "number": 60}
+	p := paginator.New(adapter.NewDocumentAdapter(suite.ctx, suite.coll, filter), 10)
+
+	require := suite.Require()
+	var docs []map[string]interface{}
+	require.NoError(p.Results(&docs))
+	require.Len(docs, 1)
+	require.EqualValues(60, docs[0]["number"])
+
+	p = paginator.New(adapter.NewDocumentAdapter(suite.ctx, suite.coll, nil,
+		adapter.DocumentSort{Field: "author.name"},
+		adapter.DocumentSort{Field: "number", Desc: true},
+	), 10)
+
+	var articles []Article
+	require.NoError(p.Results(&articles))
+	require.Equal(100, articles[0].Number)
+	require.Equal("alice", articles[0].Author.Name)
+
+	p.SetPage(6)
+	require.NoError(p.Results(&articles))
+	require.Equal(50, articles[0].Number)
+	require.Equal("bob", articles[0].Author.Name)
+}
+
+func (suite *DocumentAdapterTestSuite) TestOutOfRange() {
+	a := adapter.NewDocumentAdapter(suite.ctx, suite.coll, nil)
+
+	var articles []Article
+	suite.Require().NoError(a.Slice(150, 10, &articles))
+	suite.Require().Empty(articles)
+}
+
+func (suite *DocumentAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	_, err := adapter.NewDocumentAdapter(suite.ctx, suite.coll, "status = 'draft'").Nums()
+	require.EqualError(err, "expected map[string]interface{} filter but got string")
+
+	ctx, cancel := context.WithCancel(suite.ctx)
+	cancel()
+
+	var articles []Article
+	err = adapter.NewDocumentAdapter(ctx, suite.coll, nil).Slice(0, 10, &articles)
+	require.Equal(context.Canceled, err)
+
+	require.Error(suite.coll.Insert([]int{1, 2}))
+}
+
+func TestDocumentAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(DocumentAdapterTestSuite))
+}
@@ -0,0 +1,254 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// DefaultMaxResultWindow default index.max_result_window setting of Elasticsearch
+const DefaultMaxResultWindow = 10000
+
+var errSearchAfterSort = errors.New("elasticsearch: a sort is required to read past the max result window")
+
+type (
+	// ElasticsearchOptions search options
+	ElasticsearchOptions struct {
+		// URL of the cluster, e.g. http://localhost:9200
+		URL string
+		// Index, alias or comma separated list of indices to search
+		Index string
+		// Query the query clause of the search request, match_all by default
+		Query interface{}
+		// Sort the sort clause of the search request.
+		// It must be a total order, e.g. ending with a unique tie-breaker field, to read past MaxResultWindow.
+		Sort []interface{}
+		// MaxResultWindow the index.max_result_window setting of the index, DefaultMaxResultWindow by default.
+		// Deeper pages are read with search_after.
+		MaxResultWindow int
+		// Client the HTTP client, http.DefaultClient by default
+		Client *http.Client
+	}
+
+	// ElasticsearchAdapter adapter to be passed to paginator constructor to paginate the results of a search
+	// with Elasticsearch or OpenSearch.
+	ElasticsearchAdapter struct {
+		ctx  context.Context
+		opts ElasticsearchOptions
+
+		mu          sync.Mutex
+		approximate bool
+		// cursors search_after values of the hits at given offsets, kept raw so long numbers don't lose precision
+		cursors map[int][]json.RawMessage
+	}
+
+	esSearchRequest struct {
+		Query       interface{}       `json:"query"`
+		Sort        []interface{}     `json:"sort,omitempty"`
+		From        int               `json:"from,omitempty"`
+		Size        int               `json:"size"`
+		Source      *bool             `json:"_source,omitempty"`
+		SearchAfter []json.RawMessage `json:"search_after,omitempty"`
+	}
+
+	esSearchResponse struct {
+		Hits struct {
+			Total struct {
+				Value    int64  `json:"value"`
+				Relation string `json:"relation"`
+			} `json:"total"`
+			Hits []struct {
+				Source json.RawMessage   `json:"_source"`
+				Sort   []json.RawMessage `json:"sort"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	esErrorResponse struct {
+		Error struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	}
+)
+
+// NewElasticsearchAdapter elasticsearch adapter constructor receive the search options.
+func NewElasticsearchAdapter(ctx context.Context, opts ElasticsearchOptions) paginator.Adapter {
+	if opts.Query == nil {
+		opts.Query = map[string]interface{}{"match_all": map[string]interface{}{}}
+	}
+
+	if opts.MaxResultWindow <= 0 {
+		opts.MaxResultWindow = DefaultMaxResultWindow
+	}
+
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+
+	return &ElasticsearchAdapter{ctx: ctx, opts: opts, cursors: make(map[int][]json.RawMessage)}
+}
+
+// Nums returns the number of hits.
+// The number is a lower bound when Elasticsearch doesn't count all the hits, see Approximate.
+func (a *ElasticsearchAdapter) Nums() (int64, error) {
+	res, err := a.search(esSearchRequest{Size: 0})
+	if err != nil {
+		return 0, err
+	}
+
+	a.mu.Lock()
+	a.approximate = res.Hits.Total.Relation == "gte"
+	a.mu.Unlock()
+
+	return res.Hits.Total.Value, nil
+}
+
+// Approximate returns true if the last number of hits returned by Nums is a lower bound of the real number
+func (a *ElasticsearchAdapter) Approximate() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.approximate
+}
+
+// Slice stores into dest argument a slice of the hits sources.
+// dest argument must be a pointer to a slice
+func (a *ElasticsearchAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	req := esSearchRequest{From: offset, Size: length}
+	if offset+length > a.opts.MaxResultWindow {
+		after, err := a.searchAfter(offset)
+		if err != nil {
+			return err
+		}
+
+		if after == nil && offset > 0 {
+			return nil
+		}
+
+		req = esSearchRequest{Size: length, SearchAfter: after}
+	}
+
+	res, err := a.search(req)
+	if err != nil {
+		return err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	vt.Set(reflect.MakeSlice(vt.Type(), len(res.Hits.Hits), len(res.Hits.Hits)))
+	for i, hit := range res.Hits.Hits {
+		if err := json.Unmarshal(hit.Source, vt.Index(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("elasticsearch: hit %d: %w", offset+i, err)
+		}
+	}
+
+	return nil
+}
+
+// searchAfter returns the sort values of the hit before offset, or nil if there are less hits than offset.
+// The hits before offset are walked in windows of MaxResultWindow hits, without their sources.
+func (a *ElasticsearchAdapter) searchAfter(offset int) ([]json.RawMessage, error) {
+	if len(a.opts.Sort) == 0 {
+		return nil, errSearchAfterSort
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// start from the closest known cursor
+	var (
+		reached int
+		after   []json.RawMessage
+	)
+
+	for pos, cursor := range a.cursors {
+		if pos <= offset && pos > reached {
+			reached, after = pos, cursor
+		}
+	}
+
+	noSource := false
+	for reached < offset {
+		size := offset - reached
+		if size > a.opts.MaxResultWindow {
+			size = a.opts.MaxResultWindow
+		}
+
+		res, err := a.search(esSearchRequest{Size: size, Source: &noSource, SearchAfter: after})
+		if err != nil {
+			return nil, err
+		}
+
+		hits := res.Hits.Hits
+		if len(hits) == 0 {
+			return nil, nil
+		}
+
+		reached += len(hits)
+		after = hits[len(hits)-1].Sort
+		a.cursors[reached] = after
+
+		if len(hits) < size {
+			return nil, nil
+		}
+	}
+
+	return after, nil
+}
+
+func (a *ElasticsearchAdapter) search(req esSearchRequest) (*esSearchResponse, error) {
+	req.Query = a.opts.Query
+	req.Sort = a.opts.Sort
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/%s/_search", strings.TrimSuffix(a.opts.URL, "/"), a.opts.Index)
+	httpReq, err := http.NewRequestWithContext(a.ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpRes, err := a.opts.Client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpRes.Body.Close()
+
+	data, err := ioutil.ReadAll(httpRes.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpRes.StatusCode != http.StatusOK {
+		var esErr esErrorResponse
+		if err := json.Unmarshal(data, &esErr); err == nil && esErr.Error.Reason != "" {
+			return nil, fmt.Errorf("elasticsearch: %s: %s: %s", httpRes.Status, esErr.Error.Type, esErr.Error.Reason)
+		}
+
+		return nil, fmt.Errorf("elasticsearch: %s", httpRes.Status)
+	}
+
+	var res esSearchResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("elasticsearch: %w", err)
+	}
+
+	return &res, nil
+}
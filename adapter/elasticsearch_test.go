@@ -0,0 +1,263 @@
+package adapter_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+type (
+	esRequest struct {
+		Query       map[string]interface{} `json:"query"`
+		Sort        []interface{}          `json:"sort"`
+		From        int                    `json:"from"`
+		Size        int                    `json:"size"`
+		Source      *bool                  `json:"_source"`
+		SearchAfter []float64              `json:"search_after"`
+	}
+
+	// esServer fake search endpoint over posts numbered from 1 to nums, sorted by number.
+	// It counts the hits up to trackTotalHits, like Elasticsearch does by default.
+	esServer struct {
+		nums           int
+		window         int
+		trackTotalHits int
+		requests       []esRequest
+	}
+
+	ElasticsearchAdapterTestSuite struct {
+		suite.Suite
+		es     *esServer
+		server *httptest.Server
+	}
+)
+
+func (s *esServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/posts/_search" || r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"type":"index_not_found_exception","reason":"no such index"},"status":404}`))
+		return
+	}
+
+	var req esRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.requests = append(s.requests, req)
+	if req.From+req.Size > s.window {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"type":"illegal_argument_exception","reason":"Result window is too large"},"status":400}`))
+		return
+	}
+
+	start := req.From + 1
+	if len(req.SearchAfter) > 0 {
+		start = int(req.SearchAfter[0]) + 1
+	}
+
+	hits := make([]map[string]interface{}, 0)
+	for n := start; n <= s.nums && len(hits) < req.Size; n++ {
+		hit := map[string]interface{}{"sort": []int{n}}
+		if req.Source == nil || *req.Source {
+			hit["_source"] = Post{ID: uint(n), Number: n}
+		}
+
+		hits = append(hits, hit)
+	}
+
+	total := map[string]interface{}{"value": s.nums, "relation": "eq"}
+	if s.nums > s.trackTotalHits {
+		total = map[string]interface{}{"value": s.trackTotalHits, "relation": "gte"}
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"hits": map[string]interface{}{"total": total, "hits": hits},
+	})
+}
+
+func (suite *ElasticsearchAdapterTestSuite) SetupTest() {
+	suite.es = &esServer{nums: 100, window: 50, trackTotalHits: 10000}
+	suite.server = httptest.NewServer(suite.es)
+}
+
+func (suite *ElasticsearchAdapterTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *ElasticsearchAdapterTestSuite) newAdapter() paginator.Adapter {
+	return adapter.NewElasticsearchAdapter(context.Background(), adapter.ElasticsearchOptions{
+		URL:             suite.server.URL,
+		Index:           "posts",
+		Sort:            []interface{}{map[string]string{"number": "asc"}},
+		MaxResultWindow: 50,
+	})
+}
+
+func (suite *ElasticsearchAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(suite.newAdapter(), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	require.Equal(Post{ID: 1, Number: 1}, posts[0])
+
+	last := suite.es.requests[len(suite.es.requests)-1]
+	require.Equal(0, last.From)
+	require.Equal(10, last.Size)
+	require.Equal(map[string]interface{}{"match_all": map[string]interface{}{}}, last.Query)
+}
+
+func (suite *ElasticsearchAdapterTestSuite) TestFromSize() {
+	p := paginator.New(suite.newAdapter(), 10)
+	p.SetPage(5)
+
+	require := suite.Require()
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(41 + i), Number: 41 + i}, post)
+	}
+
+	last := suite.es.requests[len(suite.es.requests)-1]
+	require.Equal(40, last.From)
+	require.Empty(last.SearchAfter)
+}
+
+func (suite *ElasticsearchAdapterTestSuite) TestSearchAfter() {
+	a := suite.newAdapter()
+	p := paginator.New(a, 10)
+	p.SetPage(10)
+
+	require := suite.Require()
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(91 + i), Number: 91 + i}, post)
+	}
+
+	// the cursor of the previous walk is reused
+	suite.es.requests = nil
+	require.NoError(a.Slice(80, 10, &posts))
+	require.Equal(Post{ID: 81, Number: 81}, posts[0])
+	require.Len(suite.es.requests, 2)
+	require.Equal([]float64{50}, suite.es.requests[0].SearchAfter)
+	require.Equal(30, suite.es.requests[0].Size)
+	require.False(*suite.es.requests[0].Source)
+	require.Equal([]float64{80}, suite.es.requests[1].SearchAfter)
+}
+
+func (suite *ElasticsearchAdapterTestSuite) TestSearchAfterLongSortValues() {
+	// the sort values are past 2^53, where float64 can't hold every integer
+	base := int64(1<<53 + 1)
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			From        int               `json:"from"`
+			Size        int               `json:"size"`
+			SearchAfter []json.RawMessage `json:"search_after"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		start := req.From + 1
+		if len(req.SearchAfter) > 0 {
+			afters = append(afters, string(req.SearchAfter[0]))
+			after, err := strconv.ParseInt(string(req.SearchAfter[0]), 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			start = int(after-base) + 1
+		}
+
+		hits := make([]map[string]interface{}, 0)
+		for n := start; n <= 30 && len(hits) < req.Size; n++ {
+			hits = append(hits, map[string]interface{}{
+				"_source": Post{ID: uint(n), Number: n},
+				"sort":    []json.RawMessage{json.RawMessage(strconv.FormatInt(base+int64(n), 10))},
+			})
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"hits": map[string]interface{}{"total": map[string]interface{}{"value": 30, "relation": "eq"}, "hits": hits},
+		})
+	}))
+	defer server.Close()
+
+	a := adapter.NewElasticsearchAdapter(context.Background(), adapter.ElasticsearchOptions{
+		URL:             server.URL,
+		Index:           "posts",
+		Sort:            []interface{}{map[string]string{"id": "asc"}},
+		MaxResultWindow: 10,
+	})
+
+	require := suite.Require()
+	var posts []Post
+	require.NoError(a.Slice(20, 5, &posts))
+	require.Len(posts, 5)
+	require.Equal(Post{ID: 21, Number: 21}, posts[0])
+	require.Equal([]string{"9007199254741003", "9007199254741013"}, afters)
+}
+
+func (suite *ElasticsearchAdapterTestSuite) TestSearchAfterPastEnd() {
+	var posts []Post
+	suite.Require().NoError(suite.newAdapter().Slice(150, 10, &posts))
+	suite.Require().Empty(posts)
+}
+
+func (suite *ElasticsearchAdapterTestSuite) TestApproximateTotal() {
+	suite.es.trackTotalHits = 80
+	a := suite.newAdapter()
+
+	require := suite.Require()
+	require.False(a.(*adapter.ElasticsearchAdapter).Approximate())
+
+	n, err := a.Nums()
+	require.NoError(err)
+	require.EqualValues(80, n)
+	require.True(a.(*adapter.ElasticsearchAdapter).Approximate())
+}
+
+func (suite *ElasticsearchAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	_, err := adapter.NewElasticsearchAdapter(context.Background(), adapter.ElasticsearchOptions{
+		URL:   suite.server.URL,
+		Index: "missing",
+	}).Nums()
+	require.EqualError(err, "elasticsearch: 404 Not Found: index_not_found_exception: no such index")
+
+	var posts []Post
+	err = adapter.NewElasticsearchAdapter(context.Background(), adapter.ElasticsearchOptions{
+		URL:             suite.server.URL,
+		Index:           "posts",
+		MaxResultWindow: 50,
+	}).Slice(60, 10, &posts)
+	require.EqualError(err, "elasticsearch: a sort is required to read past the max result window")
+}
+
+func TestElasticsearchAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(ElasticsearchAdapterTestSuite))
+}
@@ -0,0 +1,251 @@
+package adapter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+)
+
+var (
+	stringType = reflect.TypeOf("")
+	bytesType  = reflect.TypeOf([]byte(nil))
+)
+
+// FileLinesAdapter adapter to paginate the lines of a text file, like a log file or a NDJSON export.
+// The first call builds an index with the offset of every line, so Slice seeks directly to the first line of the page.
+// The index is built again whenever the size or the modification time of the file changes.
+type FileLinesAdapter struct {
+	path      string
+	indexPath string
+
+	mu      sync.Mutex
+	index   []int64
+	size    int64
+	modTime time.Time
+}
+
+// NewFileLinesAdapter file lines adapter constructor receive the path of the file to paginate.
+func NewFileLinesAdapter(path string) paginator.Adapter {
+	return &FileLinesAdapter{path: path}
+}
+
+// NewPersistentFileLinesAdapter file lines adapter which saves the line index to indexPath and reuses it
+// as long as the file size and modification time didn't change.
+func NewPersistentFileLinesAdapter(path, indexPath string) paginator.Adapter {
+	return &FileLinesAdapter{path: path, indexPath: indexPath}
+}
+
+// Nums returns the number of lines
+func (a *FileLinesAdapter) Nums() (int64, error) {
+	index, _, err := a.lineIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(index)), nil
+}
+
+// Slice stores into dest argument a slice of the lines.
+// dest argument must be a pointer to a slice of strings, a pointer to a slice of byte slices or, for NDJSON files,
+// a pointer to a slice of any type every line is decoded into.
+func (a *FileLinesAdapter) Slice(offset, length int, dest interface{}) error {
+	index, size, err := a.lineIndex()
+	if err != nil {
+		return err
+	}
+
+	if offset > len(index) {
+		offset = len(index)
+	}
+
+	if len(index) < offset+length {
+		length = len(index) - offset
+	}
+
+	if err := makeSlice(dest, length, length); err != nil {
+		return err
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	end := size
+	if offset+length < len(index) {
+		end = index[offset+length]
+	}
+
+	f, err := os.Open(a.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := make([]byte, end-index[offset])
+	n, err := f.ReadAt(buf, index[offset])
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	// the file may have been truncated since it was indexed
+	buf = buf[:n]
+
+	vt := reflect.ValueOf(dest).Elem()
+	elemType := vt.Type().Elem()
+	for i := 0; i < length; i++ {
+		start, stop := index[offset+i]-index[offset], int64(len(buf))
+		if offset+i+1 < offset+length {
+			stop = index[offset+i+1] - index[offset]
+		}
+
+		if stop > int64(len(buf)) {
+			stop = int64(len(buf))
+		}
+
+		if start > stop {
+			return fmt.Errorf("line %d: %s changed while reading", offset+i+1, a.path)
+		}
+
+		line := trimEOL(buf[start:stop])
+		switch elemType {
+		case stringType:
+			vt.Index(i).SetString(string(line))
+		case bytesType:
+			vt.Index(i).SetBytes(append([]byte(nil), line...))
+		default:
+			if err := json.Unmarshal(line, vt.Index(i).Addr().Interface()); err != nil {
+				return fmt.Errorf("line %d: %w", offset+i+1, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// lineIndex returns the line offsets and the size of the file, building the index if the file changed
+func (a *FileLinesAdapter) lineIndex() ([]int64, int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	fi, err := os.Stat(a.path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if a.index != nil && a.size == fi.Size() && a.modTime.Equal(fi.ModTime()) {
+		return a.index, a.size, nil
+	}
+
+	a.modTime = fi.ModTime()
+	if a.indexPath != "" {
+		if index, ok := loadLineIndex(a.indexPath, fi); ok {
+			a.index, a.size = index, fi.Size()
+
+			return a.index, a.size, nil
+		}
+	}
+
+	index, err := buildLineIndex(a.path, fi.Size())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if a.indexPath != "" {
+		if err := saveLineIndex(a.indexPath, fi, index); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	a.index, a.size = index, fi.Size()
+
+	return a.index, a.size, nil
+}
+
+// buildLineIndex returns the offsets of the lines within the first size bytes of the file,
+// so the lines appended meanwhile are left for the next index
+func buildLineIndex(path string, size int64) ([]int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := io.LimitReader(f, size)
+
+	var pos int64
+	index := make([]int64, 0)
+	buf := make([]byte, 64*1024)
+	lineStart := true
+	for {
+		n, err := r.Read(buf)
+		chunk := buf[:n]
+		for len(chunk) > 0 {
+			if lineStart {
+				index = append(index, pos)
+				lineStart = false
+			}
+
+			i := bytes.IndexByte(chunk, '\n')
+			if i < 0 {
+				pos += int64(len(chunk))
+				break
+			}
+
+			pos += int64(i + 1)
+			chunk = chunk[i+1:]
+			lineStart = true
+		}
+
+		if err == io.EOF {
+			return index, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// loadLineIndex reads a persisted index which is valid only for the same file size and modification time
+func loadLineIndex(path string, fi os.FileInfo) ([]int64, bool) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil || len(data) < 16 || len(data)%8 != 0 {
+		return nil, false
+	}
+
+	if int64(binary.LittleEndian.Uint64(data)) != fi.Size() ||
+		int64(binary.LittleEndian.Uint64(data[8:])) != fi.ModTime().UnixNano() {
+		return nil, false
+	}
+
+	index := make([]int64, (len(data)-16)/8)
+	for i := range index {
+		index[i] = int64(binary.LittleEndian.Uint64(data[16+i*8:]))
+	}
+
+	return index, true
+}
+
+func saveLineIndex(path string, fi os.FileInfo, index []int64) error {
+	var buf bytes.Buffer
+	header := []int64{fi.Size(), fi.ModTime().UnixNano()}
+	if err := binary.Write(&buf, binary.LittleEndian, append(header, index...)); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
+
+func trimEOL(line []byte) []byte {
+	line = bytes.TrimSuffix(line, []byte("\n"))
+
+	return bytes.TrimSuffix(line, []byte("\r"))
+}
@@ -0,0 +1,231 @@
+package adapter_test
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type FileLinesAdapterTestSuite struct {
+	suite.Suite
+	dir  string
+	path string
+}
+
+func (suite *FileLinesAdapterTestSuite) SetupTest() {
+	suite.dir = suite.T().TempDir()
+	suite.path = filepath.Join(suite.dir, "posts.ndjson")
+
+	var b strings.Builder
+	for i := 1; i <= 100; i++ {
+		fmt.Fprintf(&b, "{\"id\":%d,\"number\":%d}\n", i, i)
+	}
+
+	suite.Require().NoError(ioutil.WriteFile(suite.path, []byte(b.String()), 0644))
+}
+
+func (suite *FileLinesAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(adapter.NewFileLinesAdapter(suite.path), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	hn, err := p.HasNext()
+	require.NoError(err)
+	require.True(hn)
+}
+
+func (suite *FileLinesAdapterTestSuite) TestStringResults() {
+	p := paginator.New(adapter.NewFileLinesAdapter(suite.path), 10)
+
+	require := suite.Require()
+	var lines []string
+	p.SetPage(6)
+
+	require.NoError(p.Results(&lines))
+	require.Len(lines, 10)
+	for i, line := range lines {
+		require.Equal(fmt.Sprintf(`{"id":%d,"number":%d}`, 51+i, 51+i), line)
+	}
+}
+
+func (suite *FileLinesAdapterTestSuite) TestNDJSONResults() {
+	p := paginator.New(adapter.NewFileLinesAdapter(suite.path), 10)
+
+	require := suite.Require()
+	var posts []Post
+	p.SetPage(10)
+
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(91 + i), Number: 91 + i}, post)
+	}
+}
+
+func (suite *FileLinesAdapterTestSuite) TestInvalidJSONLine() {
+	require := suite.Require()
+	path := filepath.Join(suite.dir, "invalid.ndjson")
+	require.NoError(ioutil.WriteFile(path, []byte("{\"id\":1}\nnot json\n"), 0644))
+
+	var posts []Post
+	err := adapter.NewFileLinesAdapter(path).Slice(0, 10, &posts)
+	require.Error(err)
+	require.Contains(err.Error(), "line 2")
+}
+
+func (suite *FileLinesAdapterTestSuite) TestLineEndings() {
+	require := suite.Require()
+	path := filepath.Join(suite.dir, "lines.txt")
+	require.NoError(ioutil.WriteFile(path, []byte("first\r\n\r\nthird\nlast"), 0644))
+
+	a := adapter.NewFileLinesAdapter(path)
+	n, err := a.Nums()
+	require.NoError(err)
+	require.EqualValues(4, n)
+
+	var lines []string
+	require.NoError(a.Slice(0, 10, &lines))
+	require.Equal([]string{"first", "", "third", "last"}, lines)
+
+	var raw [][]byte
+	require.NoError(a.Slice(2, 1, &raw))
+	require.Equal([][]byte{[]byte("third")}, raw)
+}
+
+func (suite *FileLinesAdapterTestSuite) TestEmptyFile() {
+	require := suite.Require()
+	path := filepath.Join(suite.dir, "empty.txt")
+	require.NoError(ioutil.WriteFile(path, nil, 0644))
+
+	p := paginator.New(adapter.NewFileLinesAdapter(path), 10)
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(0, n)
+
+	var lines []string
+	require.NoError(p.Results(&lines))
+	require.Empty(lines)
+}
+
+func (suite *FileLinesAdapterTestSuite) TestAppendedLines() {
+	require := suite.Require()
+	a := adapter.NewFileLinesAdapter(suite.path)
+
+	n, err := a.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	f, err := os.OpenFile(suite.path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(err)
+	_, err = f.WriteString("{\"id\":101,\"number\":101}\n")
+	require.NoError(err)
+	require.NoError(f.Close())
+
+	n, err = a.Nums()
+	require.NoError(err)
+	require.EqualValues(101, n)
+
+	var posts []Post
+	require.NoError(a.Slice(100, 10, &posts))
+	require.Equal([]Post{{ID: 101, Number: 101}}, posts)
+}
+
+func (suite *FileLinesAdapterTestSuite) TestConcurrentAppend() {
+	require := suite.Require()
+
+	// a file large enough to be still indexing while lines are appended
+	var b strings.Builder
+	for i := 1; i <= 50000; i++ {
+		fmt.Fprintf(&b, "{\"id\":%d,\"number\":%d}\n", i, i)
+	}
+
+	require.NoError(ioutil.WriteFile(suite.path, []byte(b.String()), 0644))
+
+	f, err := os.OpenFile(suite.path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(err)
+	defer f.Close()
+
+	written := make(chan error, 1)
+	go func() {
+		for i := 50001; i <= 150000; i++ {
+			if _, err := fmt.Fprintf(f, "{\"id\":%d,\"number\":%d}\n", i, i); err != nil {
+				written <- err
+				return
+			}
+		}
+
+		written <- nil
+	}()
+
+	// the file grows while it's indexed and between the index and the slice
+	for done := false; !done; {
+		select {
+		case err := <-written:
+			require.NoError(err)
+			done = true
+		default:
+		}
+
+		a := adapter.NewFileLinesAdapter(suite.path)
+		n, err := a.Nums()
+		require.NoError(err)
+
+		var lines []string
+		require.NoError(a.Slice(0, 1<<30, &lines))
+		require.True(len(lines) >= int(n))
+		require.Equal(`{"id":1,"number":1}`, lines[0])
+	}
+}
+
+func (suite *FileLinesAdapterTestSuite) TestPersistentIndex() {
+	require := suite.Require()
+	indexPath := filepath.Join(suite.dir, "posts.idx")
+
+	a := adapter.NewPersistentFileLinesAdapter(suite.path, indexPath)
+	n, err := a.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	fi, err := os.Stat(indexPath)
+	require.NoError(err)
+	require.EqualValues(16+100*8, fi.Size())
+
+	// a new adapter reuses the saved index
+	var posts []Post
+	require.NoError(adapter.NewPersistentFileLinesAdapter(suite.path, indexPath).Slice(50, 2, &posts))
+	require.Equal([]Post{{ID: 51, Number: 51}, {ID: 52, Number: 52}}, posts)
+
+	// the saved index is ignored once the file changes
+	require.NoError(ioutil.WriteFile(suite.path, []byte("{\"id\":1,\"number\":1}\n"), 0644))
+	n, err = adapter.NewPersistentFileLinesAdapter(suite.path, indexPath).Nums()
+	require.NoError(err)
+	require.EqualValues(1, n)
+}
+
+func (suite *FileLinesAdapterTestSuite) TestMissingFile() {
+	p := paginator.New(adapter.NewFileLinesAdapter(filepath.Join(suite.dir, "missing.txt")), 10)
+
+	require := suite.Require()
+	_, err := p.Nums()
+	require.True(os.IsNotExist(err))
+
+	var lines []string
+	require.Error(p.Results(&lines))
+}
+
+func TestFileLinesAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(FileLinesAdapterTestSuite))
+}
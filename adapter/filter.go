@@ -0,0 +1,215 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+	"sync"
+)
+
+var (
+	_ paginator.Peeker = (*FilterAdapter)(nil)
+
+	errFilterChanged = errors.New("the items of the inner adapter changed")
+)
+
+type (
+	// FilterAdapter adapter wrapper keeping only the items of another adapter matching a predicate, for the filters
+	// which can't be pushed into the data source, like permission checks. The inner adapter is scanned lazily, only as
+	// far as needed to fill the requested page, and the offsets of the matching items are remembered, so the page
+	// boundaries are stable. The total number of items is unknown, so Nums returns paginator.UnknownNums;
+	// see Estimate for an approximate total.
+	FilterAdapter struct {
+		index *filterIndex
+		err   error
+	}
+
+	// filterIndex offsets of the kept items of an adapter
+	filterIndex struct {
+		adapter  paginator.Adapter
+		elemType reflect.Type
+		keep     func(item reflect.Value) (bool, error)
+		prefix   string
+
+		mu      sync.Mutex
+		kept    []int
+		scanned int
+		done    bool
+	}
+)
+
+// NewFilterAdapter filter adapter constructor receive the inner adapter and the predicate of its items,
+// having the func(T) bool or func(T) (bool, error) signature. The inner adapter is read into slices of T.
+func NewFilterAdapter(adapter paginator.Adapter, predicate interface{}) paginator.Adapter {
+	v := reflect.ValueOf(predicate)
+	if v.Kind() != reflect.Func || v.IsNil() || v.Type().IsVariadic() || v.Type().NumIn() != 1 ||
+		v.Type().NumOut() < 1 || v.Type().NumOut() > 2 || v.Type().Out(0).Kind() != reflect.Bool ||
+		(v.Type().NumOut() == 2 && v.Type().Out(1) != errorType) {
+		return &FilterAdapter{
+			err: fmt.Errorf("filter: expected func(T) bool or func(T) (bool, error) but got %T", predicate),
+		}
+	}
+
+	return &FilterAdapter{index: &filterIndex{
+		adapter:  adapter,
+		elemType: v.Type().In(0),
+		prefix:   "filter",
+		keep: func(item reflect.Value) (bool, error) {
+			out := v.Call([]reflect.Value{item})
+			if len(out) == 2 && !out[1].IsNil() {
+				return false, out[1].Interface().(error)
+			}
+
+			return out[0].Bool(), nil
+		},
+	}}
+}
+
+// Nums returns paginator.UnknownNums
+func (a *FilterAdapter) Nums() (int64, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+
+	return paginator.UnknownNums, nil
+}
+
+// Estimate returns the approximate number of matching items, extrapolated from the ratio of matching items scanned
+// so far, and true if the number is exact because the inner adapter was scanned to the end.
+// The inner adapter must be able to count its items until then.
+func (a *FilterAdapter) Estimate() (int64, bool, error) {
+	if a.err != nil {
+		return 0, false, a.err
+	}
+
+	return a.index.estimate()
+}
+
+// HasMore returns true if there is a matching item at offset
+func (a *FilterAdapter) HasMore(offset int) (bool, error) {
+	if a.err != nil {
+		return false, a.err
+	}
+
+	return a.index.hasMore(offset)
+}
+
+// Slice stores into dest argument a slice of the matching items.
+// dest argument must be a pointer to a slice
+func (a *FilterAdapter) Slice(offset, length int, dest interface{}) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	return a.index.slice(offset, length, dest)
+}
+
+func (idx *filterIndex) estimate() (int64, bool, error) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.done {
+		return int64(len(idx.kept)), true, nil
+	}
+
+	n, err := idx.adapter.Nums()
+	if err != nil {
+		return 0, false, err
+	}
+
+	if n == paginator.UnknownNums {
+		return 0, false, paginator.ErrUnknownNums
+	}
+
+	if idx.scanned == 0 {
+		return n, false, nil
+	}
+
+	return n * int64(len(idx.kept)) / int64(idx.scanned), false, nil
+}
+
+func (idx *filterIndex) hasMore(offset int) (bool, error) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if err := idx.scan(offset+1, paginator.DefaultMaxPerPage); err != nil {
+		return false, err
+	}
+
+	return len(idx.kept) > offset, nil
+}
+
+func (idx *filterIndex) slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if err := idx.scan(offset+length, length); err != nil {
+		return err
+	}
+
+	if offset >= len(idx.kept) || length <= 0 {
+		return nil
+	}
+
+	end := offset + length
+	if end > len(idx.kept) {
+		end = len(idx.kept)
+	}
+
+	// read the inner items from the first to the last kept one in a single call
+	from, to := idx.kept[offset], idx.kept[end-1]+1
+	buf := reflect.New(reflect.SliceOf(idx.elemType))
+	if err := idx.adapter.Slice(from, to-from, buf.Interface()); err != nil {
+		return err
+	}
+
+	items := reflect.MakeSlice(buf.Elem().Type(), 0, end-offset)
+	for _, pos := range idx.kept[offset:end] {
+		if pos-from >= buf.Elem().Len() {
+			return fmt.Errorf("%s: %w", idx.prefix, errFilterChanged)
+		}
+
+		items = reflect.Append(items, buf.Elem().Index(pos-from))
+	}
+
+	return NewSliceAdapter(items.Interface()).Slice(0, items.Len(), dest)
+}
+
+// scan reads the inner adapter in batches until n items are kept or the inner adapter is exhausted
+func (idx *filterIndex) scan(n, batch int) error {
+	if batch <= 0 {
+		batch = paginator.DefaultMaxPerPage
+	}
+
+	for len(idx.kept) < n && !idx.done {
+		buf := reflect.New(reflect.SliceOf(idx.elemType))
+		if err := idx.adapter.Slice(idx.scanned, batch, buf.Interface()); err != nil {
+			return err
+		}
+
+		// the batch is committed only once all its items are checked, so a failed batch can be retried
+		items := buf.Elem()
+		kept := idx.kept
+		for i := 0; i < items.Len(); i++ {
+			ok, err := idx.keep(items.Index(i))
+			if err != nil {
+				return fmt.Errorf("%s: item %d: %w", idx.prefix, idx.scanned+i, err)
+			}
+
+			if ok {
+				kept = append(kept, idx.scanned+i)
+			}
+		}
+
+		idx.kept = kept
+		idx.scanned += items.Len()
+		idx.done = items.Len() < batch
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package adapter_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"testing"
+)
+
+type FilterAdapterTestSuite struct {
+	suite.Suite
+	inner *spyAdapter
+}
+
+func (suite *FilterAdapterTestSuite) SetupTest() {
+	posts := make([]Post, 100)
+	for i := range posts {
+		posts[i] = Post{ID: uint(i + 1), Number: i + 1}
+	}
+
+	suite.inner = &spyAdapter{Adapter: adapter.NewSliceAdapter(posts)}
+}
+
+// newAdapter keeps the posts having a number multiple of 3
+func (suite *FilterAdapterTestSuite) newAdapter() paginator.Adapter {
+	return adapter.NewFilterAdapter(suite.inner, func(p Post) bool {
+		return p.Number%3 == 0
+	})
+}
+
+func (suite *FilterAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(suite.newAdapter(), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.Equal(paginator.UnknownNums, n)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(3*(i+1), post.Number)
+	}
+
+	// the inner adapter is over-fetched until the page is full
+	require.Equal([][2]int{{0, 10}, {10, 10}, {20, 10}, {2, 28}}, suite.inner.slices)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(2, pn)
+}
+
+func (suite *FilterAdapterTestSuite) TestAllPages() {
+	p := paginator.New(suite.newAdapter(), 10)
+
+	require := suite.Require()
+	var numbers []int
+	for page := 1; page <= 4; page++ {
+		p.SetPage(page)
+
+		var posts []Post
+		require.NoError(p.Results(&posts))
+		for _, post := range posts {
+			numbers = append(numbers, post.Number)
+		}
+	}
+
+	require.Len(numbers, 33)
+	require.Equal(99, numbers[32])
+
+	hasNext, err := p.HasNext()
+	require.NoError(err)
+	require.False(hasNext)
+}
+
+func (suite *FilterAdapterTestSuite) TestEstimate() {
+	a := suite.newAdapter().(*adapter.FilterAdapter)
+
+	require := suite.Require()
+	n, exact, err := a.Estimate()
+	require.NoError(err)
+	require.False(exact)
+	require.EqualValues(100, n)
+
+	var posts []Post
+	require.NoError(a.Slice(0, 10, &posts))
+	n, exact, err = a.Estimate()
+	require.NoError(err)
+	require.False(exact)
+	require.EqualValues(33, n)
+
+	require.NoError(a.Slice(30, 10, &posts))
+	require.Len(posts, 3)
+	n, exact, err = a.Estimate()
+	require.NoError(err)
+	require.True(exact)
+	require.EqualValues(33, n)
+}
+
+func (suite *FilterAdapterTestSuite) TestPredicateError() {
+	fail := true
+	a := adapter.NewFilterAdapter(suite.inner, func(p Post) (bool, error) {
+		if p.Number == 15 && fail {
+			return false, errors.New("permission denied")
+		}
+
+		return p.Number%3 == 0, nil
+	})
+
+	require := suite.Require()
+	var posts []Post
+	require.EqualError(a.Slice(0, 10, &posts), "filter: item 14: permission denied")
+
+	// the failed batch is checked again
+	fail = false
+	require.NoError(a.Slice(0, 10, &posts))
+	require.Len(posts, 10)
+	require.Equal(30, posts[9].Number)
+}
+
+func (suite *FilterAdapterTestSuite) TestOffsetBeyondEnd() {
+	var posts []Post
+	suite.Require().NoError(suite.newAdapter().Slice(50, 10, &posts))
+	suite.Require().Empty(posts)
+}
+
+func (suite *FilterAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	var posts []Post
+	err := adapter.NewFilterAdapter(suite.inner, func(p Post) int { return 0 }).Slice(0, 10, &posts)
+	require.EqualError(err, "filter: expected func(T) bool or func(T) (bool, error) but got func(adapter_test.Post) int")
+
+	_, _, err = adapter.NewFilterAdapter(adapter.NewChanAdapter(make(chan Post)), func(p Post) bool {
+		return true
+	}).(*adapter.FilterAdapter).Estimate()
+	require.Equal(paginator.ErrUnknownNums, err)
+
+	err = adapter.NewFilterAdapter(adapter.NewSliceAdapter(nil), func(p Post) bool { return true }).Slice(0, 10, &posts)
+	require.EqualError(err, "expected slice but got nil")
+}
+
+func TestFilterAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(FilterAdapterTestSuite))
+}
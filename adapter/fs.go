@@ -0,0 +1,239 @@
+package adapter
+
+import (
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"io/fs"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FSSortBy directory entries ordering
+type FSSortBy int
+
+const (
+	// SortByName orders the entries by name
+	SortByName FSSortBy = iota
+	// SortBySize orders the entries by size
+	SortBySize
+	// SortByModTime orders the entries by modification time
+	SortByModTime
+)
+
+var (
+	dirEntryType = reflect.TypeOf((*fs.DirEntry)(nil)).Elem()
+	fileInfoType = reflect.TypeOf((*fs.FileInfo)(nil)).Elem()
+)
+
+type (
+	// FSOptions directory listing options
+	FSOptions struct {
+		// SortBy entries ordering, ties are ordered by name
+		SortBy FSSortBy
+		// Desc reverses the ordering
+		Desc bool
+		// DirsFirst lists the directories before the files
+		DirsFirst bool
+		// Pattern lists only the entries whose name matches the pattern, see path.Match for the syntax
+		Pattern string
+		// Recursive lists the entries of the subdirectories too.
+		// The entries are named by their path relative to the listed directory.
+		Recursive bool
+	}
+
+	// FSAdapter adapter to paginate the entries of a directory of a fs.FS, like os.DirFS, embed.FS or fstest.MapFS.
+	FSAdapter struct {
+		fsys fs.FS
+		dir  string
+		opts FSOptions
+	}
+
+	// fsEntry directory entry named by its path
+	fsEntry struct {
+		fs.DirEntry
+		name string
+		info fs.FileInfo
+	}
+
+	// fsFileInfo file info named by its path
+	fsFileInfo struct {
+		fs.FileInfo
+		name string
+	}
+)
+
+// NewFSAdapter fs adapter constructor receive the file system and the directory which needs to be listed.
+func NewFSAdapter(fsys fs.FS, dir string, opts FSOptions) paginator.Adapter {
+	return &FSAdapter{fsys: fsys, dir: dir, opts: opts}
+}
+
+// Nums returns the number of entries
+func (a *FSAdapter) Nums() (int64, error) {
+	entries, err := a.list()
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(entries)), nil
+}
+
+// Slice stores into dest argument a slice of the entries.
+// dest argument must be a pointer to a slice of fs.DirEntry or fs.FileInfo.
+func (a *FSAdapter) Slice(offset, length int, dest interface{}) error {
+	if !isPtr(dest) || !isSlice(dest) {
+		return fmt.Errorf("expected to be a slice pointer but got %T", dest)
+	}
+
+	elemType := reflect.TypeOf(dest).Elem().Elem()
+	if elemType != dirEntryType && elemType != fileInfoType {
+		return fmt.Errorf("fs: unsupported destination %s", elemType)
+	}
+
+	entries, err := a.list()
+	if err != nil {
+		return err
+	}
+
+	if offset > len(entries) {
+		offset = len(entries)
+	}
+
+	if len(entries) < offset+length {
+		length = len(entries) - offset
+	}
+
+	if err := makeSlice(dest, length, length); err != nil {
+		return err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	for i, entry := range entries[offset : offset+length] {
+		if elemType == dirEntryType {
+			vt.Index(i).Set(reflect.ValueOf(entry))
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		vt.Index(i).Set(reflect.ValueOf(info))
+	}
+
+	return nil
+}
+
+// list returns the sorted and filtered entries
+func (a *FSAdapter) list() ([]*fsEntry, error) {
+	entries := make([]*fsEntry, 0)
+	add := func(name string, d fs.DirEntry) error {
+		if a.opts.Pattern != "" {
+			ok, err := path.Match(a.opts.Pattern, d.Name())
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				return nil
+			}
+		}
+
+		entry := &fsEntry{DirEntry: d, name: name}
+		if a.opts.SortBy != SortByName {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			entry.info = info
+		}
+
+		entries = append(entries, entry)
+
+		return nil
+	}
+
+	if a.opts.Recursive {
+		err := fs.WalkDir(a.fsys, a.dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if p == a.dir {
+				return nil
+			}
+
+			return add(strings.TrimPrefix(p, strings.TrimSuffix(a.dir, "/")+"/"), d)
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		des, err := fs.ReadDir(a.fsys, a.dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range des {
+			if err := add(d.Name(), d); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return a.less(entries[i], entries[j])
+	})
+
+	return entries, nil
+}
+
+func (a *FSAdapter) less(x, y *fsEntry) bool {
+	if a.opts.DirsFirst && x.IsDir() != y.IsDir() {
+		return x.IsDir()
+	}
+
+	if a.opts.Desc {
+		x, y = y, x
+	}
+
+	switch a.opts.SortBy {
+	case SortBySize:
+		if x.info.Size() != y.info.Size() {
+			return x.info.Size() < y.info.Size()
+		}
+	case SortByModTime:
+		if !x.info.ModTime().Equal(y.info.ModTime()) {
+			return x.info.ModTime().Before(y.info.ModTime())
+		}
+	}
+
+	return x.name < y.name
+}
+
+// Name returns the path of the entry relative to the listed directory
+func (e *fsEntry) Name() string {
+	return e.name
+}
+
+// Info returns the file info of the entry
+func (e *fsEntry) Info() (fs.FileInfo, error) {
+	if e.info == nil {
+		info, err := e.DirEntry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		e.info = info
+	}
+
+	return &fsFileInfo{FileInfo: e.info, name: e.name}, nil
+}
+
+// Name returns the path of the file relative to the listed directory
+func (fi *fsFileInfo) Name() string {
+	return fi.name
+}
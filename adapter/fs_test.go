@@ -0,0 +1,159 @@
+package adapter_test
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+type FSAdapterTestSuite struct {
+	suite.Suite
+	fsys fstest.MapFS
+}
+
+func (suite *FSAdapterTestSuite) SetupTest() {
+	now := time.Now()
+	suite.fsys = fstest.MapFS{
+		"docs/b.txt":         {Data: []byte("bb"), ModTime: now.Add(-time.Hour)},
+		"docs/a.md":          {Data: []byte("aaaa"), ModTime: now},
+		"docs/c.txt":         {Data: []byte("c"), ModTime: now.Add(-2 * time.Hour)},
+		"docs/sub/d.txt":     {Data: []byte("ddd"), ModTime: now},
+		"docs/sub/deep/e.md": {Data: []byte("e"), ModTime: now},
+	}
+
+	for i := 1; i <= 25; i++ {
+		suite.fsys[fmt.Sprintf("many/file-%02d.txt", i)] = &fstest.MapFile{Data: []byte("x")}
+	}
+}
+
+func (suite *FSAdapterTestSuite) names(a paginator.Adapter) []string {
+	require := suite.Require()
+
+	var entries []fs.DirEntry
+	require.NoError(a.Slice(0, 100, &entries))
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+
+	return names
+}
+
+func (suite *FSAdapterTestSuite) TestPages() {
+	p := paginator.New(adapter.NewFSAdapter(suite.fsys, "many", adapter.FSOptions{}), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(25, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(3, pn)
+
+	var entries []fs.DirEntry
+	p.SetPage(3)
+	require.NoError(p.Results(&entries))
+	require.Len(entries, 5)
+	require.Equal("file-21.txt", entries[0].Name())
+
+	var infos []fs.FileInfo
+	require.NoError(p.Results(&infos))
+	require.Len(infos, 5)
+	require.Equal("file-25.txt", infos[4].Name())
+	require.EqualValues(1, infos[4].Size())
+}
+
+func (suite *FSAdapterTestSuite) TestSorting() {
+	tests := []struct {
+		name     string
+		opts     adapter.FSOptions
+		expected []string
+	}{
+		{
+			name:     "name",
+			opts:     adapter.FSOptions{},
+			expected: []string{"a.md", "b.txt", "c.txt", "sub"},
+		},
+		{
+			name:     "name desc",
+			opts:     adapter.FSOptions{Desc: true},
+			expected: []string{"sub", "c.txt", "b.txt", "a.md"},
+		},
+		{
+			name:     "dirs first",
+			opts:     adapter.FSOptions{DirsFirst: true},
+			expected: []string{"sub", "a.md", "b.txt", "c.txt"},
+		},
+		{
+			name:     "size",
+			opts:     adapter.FSOptions{SortBy: adapter.SortBySize, DirsFirst: true},
+			expected: []string{"sub", "c.txt", "b.txt", "a.md"},
+		},
+		{
+			name:     "mtime desc",
+			opts:     adapter.FSOptions{SortBy: adapter.SortByModTime, Desc: true, Pattern: "*.*"},
+			expected: []string{"a.md", "b.txt", "c.txt"},
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.Require().Equal(test.expected, suite.names(adapter.NewFSAdapter(suite.fsys, "docs", test.opts)))
+		})
+	}
+}
+
+func (suite *FSAdapterTestSuite) TestRecursive() {
+	a := adapter.NewFSAdapter(suite.fsys, "docs", adapter.FSOptions{Recursive: true})
+	suite.Require().Equal(
+		[]string{"a.md", "b.txt", "c.txt", "sub", "sub/d.txt", "sub/deep", "sub/deep/e.md"},
+		suite.names(a),
+	)
+
+	a = adapter.NewFSAdapter(suite.fsys, ".", adapter.FSOptions{Recursive: true, Pattern: "*.md"})
+	suite.Require().Equal([]string{"docs/a.md", "docs/sub/deep/e.md"}, suite.names(a))
+
+	var infos []fs.FileInfo
+	suite.Require().NoError(a.Slice(1, 1, &infos))
+	suite.Require().Equal("docs/sub/deep/e.md", infos[0].Name())
+}
+
+func (suite *FSAdapterTestSuite) TestDirFS() {
+	require := suite.Require()
+	dir := suite.T().TempDir()
+	require.NoError(os.Mkdir(filepath.Join(dir, "sub"), 0755))
+	for _, name := range []string{"one.log", "two.log", "notes.txt"} {
+		require.NoError(ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644))
+	}
+
+	a := adapter.NewFSAdapter(os.DirFS(dir), ".", adapter.FSOptions{Pattern: "*.log"})
+	require.Equal([]string{"one.log", "two.log"}, suite.names(a))
+}
+
+func (suite *FSAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	_, err := adapter.NewFSAdapter(suite.fsys, "missing", adapter.FSOptions{}).Nums()
+	require.Error(err)
+
+	_, err = adapter.NewFSAdapter(suite.fsys, "docs", adapter.FSOptions{Pattern: "["}).Nums()
+	require.Error(err)
+
+	var names []string
+	err = adapter.NewFSAdapter(suite.fsys, "docs", adapter.FSOptions{}).Slice(0, 10, &names)
+	require.EqualError(err, "fs: unsupported destination string")
+}
+
+func TestFSAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(FSAdapterTestSuite))
+}
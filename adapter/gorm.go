@@ -28,6 +28,15 @@ func (a *GORMAdapter) Nums() (int64, error) {
 // Slice stores into data argument a slice of the results.
 // data must be a pointer to a slice of models.
 func (a *GORMAdapter) Slice(offset, length int, data interface{}) error {
+	if err := makeSlice(data, 0, 0); err != nil {
+		return err
+	}
+
+	// a zero limit means no limit for gorm
+	if length <= 0 {
+		return nil
+	}
+
 	// Work on a dedicated session to not offset the total count nums
-	return a.db.Session(&gorm.Session{}).Limit(length).Offset(offset).Find(data).Error
+	return a.db.Session(&gorm.Session{WithConditions: true}).Limit(length).Offset(offset).Find(data).Error
 }
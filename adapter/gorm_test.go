@@ -125,6 +125,23 @@ func (suite *GORMAdapterTestSuite) TestOutOfRangeCurrentPage() {
 	require.Len(posts, 10)
 }
 
+func (suite *GORMAdapterTestSuite) TestQueryConditions() {
+	q := suite.db.Model(Post{}).Where("number > ?", 50).Order("number desc")
+	p := paginator.New(adapter.NewGORMAdapter(q), 10)
+
+	require := suite.Require()
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(5, pn)
+
+	var posts []Post
+	p.SetPage(2)
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	require.Equal(90, posts[0].Number)
+	require.Equal(81, posts[9].Number)
+}
+
 func (suite *GORMAdapterTestSuite) TestCurrentPageResults() {
 	q := suite.db.Model(Post{})
 	p := paginator.New(adapter.NewGORMAdapter(q), 10)
@@ -0,0 +1,159 @@
+package adapter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"io"
+	"os"
+	"reflect"
+	"sync"
+)
+
+// JSONArrayAdapter adapter to paginate the elements of a top-level JSON array without unmarshalling the whole document.
+// The first call tokenizes the array with a streaming decoder and indexes the byte offsets of every element,
+// so Slice decodes only the elements of the requested page.
+type JSONArrayAdapter struct {
+	open func() (io.ReadSeeker, func() error, error)
+
+	mu    sync.Mutex
+	index [][2]int64
+}
+
+// NewJSONArrayAdapter json array adapter constructor receive the reader of the JSON document.
+func NewJSONArrayAdapter(r io.ReadSeeker) paginator.Adapter {
+	return &JSONArrayAdapter{
+		open: func() (io.ReadSeeker, func() error, error) {
+			return r, func() error { return nil }, nil
+		},
+	}
+}
+
+// NewJSONArrayFileAdapter json array adapter constructor receive the path of the JSON file.
+func NewJSONArrayFileAdapter(path string) paginator.Adapter {
+	return &JSONArrayAdapter{
+		open: func() (io.ReadSeeker, func() error, error) {
+			f, err := os.Open(path)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return f, f.Close, nil
+		},
+	}
+}
+
+// Nums returns the number of elements of the array
+func (a *JSONArrayAdapter) Nums() (int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.buildIndex(); err != nil {
+		return 0, err
+	}
+
+	return int64(len(a.index)), nil
+}
+
+// Slice stores into dest argument a slice of the decoded elements.
+// dest argument must be a pointer to a slice
+func (a *JSONArrayAdapter) Slice(offset, length int, dest interface{}) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.buildIndex(); err != nil {
+		return err
+	}
+
+	if offset > len(a.index) {
+		offset = len(a.index)
+	}
+
+	if len(a.index) < offset+length {
+		length = len(a.index) - offset
+	}
+
+	if err := makeSlice(dest, length, length); err != nil {
+		return err
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	rs, closeFn, err := a.open()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	start, end := a.index[offset][0], a.index[offset+length-1][1]
+	if _, err := rs.Seek(start, io.SeekStart); err != nil {
+		return err
+	}
+
+	buf := make([]byte, end-start)
+	if _, err := io.ReadFull(rs, buf); err != nil {
+		return err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	for i := 0; i < length; i++ {
+		pos := a.index[offset+i]
+		elem := bytes.TrimLeft(buf[pos[0]-start:pos[1]-start], " \t\r\n,")
+		if err := json.Unmarshal(elem, vt.Index(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("json: element %d: %w", offset+i, err)
+		}
+	}
+
+	return nil
+}
+
+// buildIndex stores the start and end offsets of every array element on first call
+func (a *JSONArrayAdapter) buildIndex() error {
+	if a.index != nil {
+		return nil
+	}
+
+	rs, closeFn, err := a.open()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bufio.NewReaderSize(rs, 64*1024))
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("json: %w", err)
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("json: expected array but got %v", tok)
+	}
+
+	index := make([][2]int64, 0)
+	for dec.More() {
+		start := dec.InputOffset()
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("json: element %d: %w", len(index), err)
+		}
+
+		index = append(index, [2]int64{start, dec.InputOffset()})
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("json: %w", err)
+	}
+
+	a.index = index
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package adapter_test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type JSONArrayAdapterTestSuite struct {
+	suite.Suite
+	path string
+}
+
+func (suite *JSONArrayAdapterTestSuite) SetupTest() {
+	posts := make([]Post, 100)
+	for i := range posts {
+		posts[i] = Post{ID: uint(i + 1), Number: i + 1}
+	}
+
+	data, err := json.MarshalIndent(posts, "", "  ")
+	suite.Require().NoError(err)
+
+	suite.path = filepath.Join(suite.T().TempDir(), "posts.json")
+	suite.Require().NoError(ioutil.WriteFile(suite.path, data, 0644))
+}
+
+func (suite *JSONArrayAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(adapter.NewJSONArrayFileAdapter(suite.path), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	hp, err := p.HasPrev()
+	require.NoError(err)
+	require.False(hp)
+}
+
+func (suite *JSONArrayAdapterTestSuite) TestCurrentPageResults() {
+	p := paginator.New(adapter.NewJSONArrayFileAdapter(suite.path), 10)
+
+	require := suite.Require()
+	var posts []Post
+	p.SetPage(6)
+
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(51 + i), Number: 51 + i}, post)
+	}
+}
+
+func (suite *JSONArrayAdapterTestSuite) TestMixedElements() {
+	r := strings.NewReader(`[1, "two" ,{"three":[3, "]"]},null,[5]]`)
+	a := adapter.NewJSONArrayAdapter(r)
+
+	require := suite.Require()
+	n, err := a.Nums()
+	require.NoError(err)
+	require.EqualValues(5, n)
+
+	var items []json.RawMessage
+	require.NoError(a.Slice(1, 3, &items))
+	require.Equal([]json.RawMessage{
+		json.RawMessage(`"two"`),
+		json.RawMessage(`{"three":[3, "]"]}`),
+		json.RawMessage(`null`),
+	}, items)
+}
+
+func (suite *JSONArrayAdapterTestSuite) TestEmptyArray() {
+	p := paginator.New(adapter.NewJSONArrayAdapter(strings.NewReader(" [ ] ")), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(0, n)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Empty(posts)
+}
+
+func (suite *JSONArrayAdapterTestSuite) TestInvalidDocument() {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "object",
+			data: `{"id": 1}`,
+			err:  "json: expected array but got {",
+		},
+		{
+			name: "truncated",
+			data: `[{"id": 1}, {"id":`,
+			err:  "json: element 1: unexpected EOF",
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			_, err := adapter.NewJSONArrayAdapter(strings.NewReader(test.data)).Nums()
+			suite.Require().EqualError(err, test.err)
+		})
+	}
+}
+
+func (suite *JSONArrayAdapterTestSuite) TestInvalidElementType() {
+	var numbers []int
+	err := adapter.NewJSONArrayFileAdapter(suite.path).Slice(0, 10, &numbers)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "json: element 0")
+}
+
+func TestJSONArrayAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(JSONArrayAdapterTestSuite))
+}
@@ -0,0 +1,196 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var _ paginator.Peeker = (*LinkAdapter)(nil)
+
+type (
+	// LinkOptions upstream API options
+	LinkOptions struct {
+		// Client the HTTP client, http.DefaultClient by default
+		Client *http.Client
+		// Header is added to every request, e.g. for authentication
+		Header http.Header
+		// ItemsPath the dotted path of the items array in the response body.
+		// An empty path means the body is the items array.
+		ItemsPath string
+	}
+
+	// LinkAdapter adapter for upstream APIs which expose their pages only through RFC 8288 Link headers,
+	// like GitHub's API. It follows the rel="next" links lazily, only as far as needed to serve the requested page,
+	// and caches the items of the fetched pages.
+	// The total number of items is unknown, so Nums returns paginator.UnknownNums.
+	LinkAdapter struct {
+		ctx  context.Context
+		opts LinkOptions
+
+		mu    sync.Mutex
+		items []json.RawMessage
+		next  string
+	}
+)
+
+// NewLinkAdapter link adapter constructor receive the URL of the first upstream page.
+func NewLinkAdapter(ctx context.Context, firstURL string, opts LinkOptions) paginator.Adapter {
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+
+	return &LinkAdapter{ctx: ctx, opts: opts, next: firstURL}
+}
+
+// Nums returns paginator.UnknownNums
+func (a *LinkAdapter) Nums() (int64, error) {
+	return paginator.UnknownNums, nil
+}
+
+// HasMore returns true if there is an item at offset
+func (a *LinkAdapter) HasMore(offset int) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.fill(offset + 1); err != nil {
+		return false, err
+	}
+
+	return len(a.items) > offset, nil
+}
+
+// Slice stores into dest argument a slice of the decoded items.
+// dest argument must be a pointer to a slice
+func (a *LinkAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.fill(offset + length); err != nil {
+		return err
+	}
+
+	if offset > len(a.items) {
+		offset = len(a.items)
+	}
+
+	if len(a.items) < offset+length {
+		length = len(a.items) - offset
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	vt.Set(reflect.MakeSlice(vt.Type(), length, length))
+	for i, item := range a.items[offset : offset+length] {
+		if err := json.Unmarshal(item, vt.Index(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("link: item %d: %w", offset+i, err)
+		}
+	}
+
+	return nil
+}
+
+// fill follows the next links until there are n cached items or there is no next link
+func (a *LinkAdapter) fill(n int) error {
+	for len(a.items) < n && a.next != "" {
+		header, body, err := httpGet(a.ctx, a.opts.Client, a.next, a.opts.Header)
+		if err != nil {
+			return err
+		}
+
+		raw, err := jsonPath(body, a.opts.ItemsPath)
+		if err != nil {
+			return err
+		}
+
+		var items []json.RawMessage
+		if raw != nil {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return fmt.Errorf("link: items: %w", err)
+			}
+		}
+
+		base, err := url.Parse(a.next)
+		if err != nil {
+			return err
+		}
+
+		a.items = append(a.items, items...)
+		a.next = parseLinks(header.Values("Link"), base)["next"]
+	}
+
+	return nil
+}
+
+// parseLinks returns the URLs of the Link header values by relation type, resolved against base
+func parseLinks(values []string, base *url.URL) map[string]string {
+	links := make(map[string]string)
+	for _, value := range values {
+		for _, link := range splitLinks(value) {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+
+			u, err := base.Parse(target[1 : len(target)-1])
+			if err != nil {
+				continue
+			}
+
+			for _, param := range parts[1:] {
+				name, val := param, ""
+				if i := strings.Index(param, "="); i >= 0 {
+					name, val = param[:i], param[i+1:]
+				}
+
+				if !strings.EqualFold(strings.TrimSpace(name), "rel") {
+					continue
+				}
+
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(val), `"`)) {
+					rel = strings.ToLower(rel)
+					if _, ok := links[rel]; !ok {
+						links[rel] = u.String()
+					}
+				}
+			}
+		}
+	}
+
+	return links
+}
+
+// splitLinks splits a Link header value into links, ignoring the commas within <> and quotes
+func splitLinks(value string) []string {
+	var (
+		links        []string
+		start        int
+		inURI, inStr bool
+	)
+
+	for i, c := range value {
+		switch {
+		case c == '<' && !inStr:
+			inURI = true
+		case c == '>' && !inStr:
+			inURI = false
+		case c == '"' && !inURI:
+			inStr = !inStr
+		case c == ',' && !inURI && !inStr:
+			links = append(links, value[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(links, value[start:])
+}
@@ -0,0 +1,153 @@
+package adapter_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+type LinkAdapterTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []string
+}
+
+// SetupTest starts an upstream service listing 45 posts, 10 at once, on /posts?page= with GitHub-style Link headers.
+// The next links are relative and the cursor isn't a page number, so they can only be followed.
+func (suite *LinkAdapterTestSuite) SetupTest() {
+	suite.requests = nil
+	mux := http.NewServeMux()
+	mux.HandleFunc("/posts", func(w http.ResponseWriter, r *http.Request) {
+		suite.requests = append(suite.requests, r.URL.RawQuery)
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		from := 0
+		if c := r.URL.Query().Get("cursor"); c != "" {
+			from, _ = strconv.Atoi(c)
+		}
+
+		items := make([]Post, 0)
+		for i := from; i < from+10 && i < 45; i++ {
+			items = append(items, Post{ID: uint(i + 1), Number: i + 1})
+		}
+
+		links := `<https://example.com/first>; rel="first"`
+		if from+10 < 45 {
+			links = fmt.Sprintf(`<?cursor=%d>; rel="next last", `, from+10) + links
+		}
+
+		w.Header().Add("Link", links)
+		w.Header().Add("Link", `<https://example.com/docs?a=1,2>; title="a, b"; rel="help"`)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": items})
+	})
+
+	suite.server = httptest.NewServer(mux)
+}
+
+func (suite *LinkAdapterTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *LinkAdapterTestSuite) newAdapter() paginator.Adapter {
+	return adapter.NewLinkAdapter(context.Background(), suite.server.URL+"/posts", adapter.LinkOptions{
+		Header:    http.Header{"Authorization": {"Bearer token"}},
+		ItemsPath: "data",
+	})
+}
+
+func (suite *LinkAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(suite.newAdapter(), 4)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.Equal(paginator.UnknownNums, n)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 4)
+	require.Equal(Post{ID: 1, Number: 1}, posts[0])
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(2, pn)
+	require.Equal([]string{""}, suite.requests)
+}
+
+func (suite *LinkAdapterTestSuite) TestRechunking() {
+	a := suite.newAdapter()
+	p := paginator.New(a, 7)
+	p.SetPage(3)
+
+	require := suite.Require()
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 7)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(15 + i), Number: 15 + i}, post)
+	}
+
+	require.Equal([]string{"", "cursor=10", "cursor=20"}, suite.requests)
+
+	// the fetched upstream pages are cached
+	suite.requests = nil
+	require.NoError(a.Slice(0, 20, &posts))
+	require.Len(posts, 20)
+	require.Empty(suite.requests)
+}
+
+func (suite *LinkAdapterTestSuite) TestLastPage() {
+	a := suite.newAdapter()
+	p := paginator.New(a, 10)
+	p.SetPage(5)
+
+	require := suite.Require()
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 5)
+	require.Equal(Post{ID: 41, Number: 41}, posts[0])
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(5, pn)
+
+	hasNext, err := p.HasNext()
+	require.NoError(err)
+	require.False(hasNext)
+
+	// no request past the last upstream page
+	suite.requests = nil
+	require.NoError(a.Slice(60, 10, &posts))
+	require.Empty(posts)
+	require.Empty(suite.requests)
+}
+
+func (suite *LinkAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	var posts []Post
+	err := adapter.NewLinkAdapter(context.Background(), suite.server.URL+"/posts", adapter.LinkOptions{}).
+		Slice(0, 10, &posts)
+	require.Error(err)
+	require.Contains(err.Error(), "401 Unauthorized")
+
+	err = adapter.NewLinkAdapter(context.Background(), suite.server.URL+"/posts", adapter.LinkOptions{
+		Header: http.Header{"Authorization": {"Bearer token"}},
+	}).Slice(0, 10, &posts)
+	require.Error(err)
+	require.Contains(err.Error(), "link: items")
+}
+
+func TestLinkAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(LinkAdapterTestSuite))
+}
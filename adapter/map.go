@@ -0,0 +1,218 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"math"
+	"reflect"
+	"sort"
+)
+
+type (
+	// KeyValue map entry. Pass a pointer to a slice of KeyValue to MapAdapter.Slice to get both keys and values.
+	KeyValue struct {
+		Key   interface{}
+		Value interface{}
+	}
+
+	// MapAdapter map adapter to be passed to paginator constructor to paginate the values of a map.
+	MapAdapter struct {
+		src  interface{}
+		less func(a, b interface{}) bool
+		err  error
+	}
+)
+
+var keyValueType = reflect.TypeOf(KeyValue{})
+
+// NewMapAdapter map adapter constructor receive the map which needs to be paginated.
+// The entries are ordered by key kind: booleans, integers, floats, strings and then any other key,
+// and within a kind by value, any other key by its type and its string form.
+func NewMapAdapter(source interface{}) paginator.Adapter {
+	return NewMapAdapterWithLess(source, nil)
+}
+
+// NewMapAdapterWithLess map adapter constructor ordering the entries by the given less function of keys.
+func NewMapAdapterWithLess(source interface{}, less func(a, b interface{}) bool) paginator.Adapter {
+	if less == nil {
+		less = lessKeys
+	}
+
+	v := reflect.ValueOf(source)
+	switch {
+	case !v.IsValid():
+		return &MapAdapter{err: errors.New("expected map but got nil")}
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return &MapAdapter{err: fmt.Errorf("expected map but got nil %T", source)}
+	case reflect.Indirect(v).Kind() != reflect.Map:
+		return &MapAdapter{err: fmt.Errorf("expected map but got %T", source)}
+	}
+
+	return &MapAdapter{src: source, less: less}
+}
+
+// Nums returns the number of entries
+func (a *MapAdapter) Nums() (int64, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+
+	return int64(a.value().Len()), nil
+}
+
+// Slice stores into dest argument a slice of the results.
+// dest argument must be a pointer to a slice of map values or a pointer to a slice of KeyValue.
+func (a *MapAdapter) Slice(offset, length int, dest interface{}) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	m := a.value()
+	keys := a.sortedKeys(m)
+	if offset > len(keys) {
+		offset = len(keys)
+	}
+
+	if len(keys) < offset+length {
+		length = len(keys) - offset
+	}
+
+	if err := makeSlice(dest, length, length); err != nil {
+		return err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	pairs := vt.Type().Elem() == keyValueType
+	for i, key := range keys[offset : offset+length] {
+		value := m.MapIndex(key)
+		if pairs {
+			vt.Index(i).Set(reflect.ValueOf(KeyValue{Key: key.Interface(), Value: value.Interface()}))
+			continue
+		}
+
+		if err := assignValue(vt.Index(i), value); err != nil {
+			return fmt.Errorf("key %v: %w", key.Interface(), err)
+		}
+	}
+
+	return nil
+}
+
+// assignValue sets dst to the map value v, unwrapping the interface values
+func assignValue(dst, v reflect.Value) error {
+	if v.Type().AssignableTo(dst.Type()) {
+		dst.Set(v)
+		return nil
+	}
+
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return fmt.Errorf("expected %s but got nil", dst.Type())
+		}
+
+		v = v.Elem()
+	}
+
+	if !v.Type().AssignableTo(dst.Type()) {
+		return fmt.Errorf("expected %s but got %s", dst.Type(), v.Type())
+	}
+
+	dst.Set(v)
+
+	return nil
+}
+
+// value returns the source map
+func (a *MapAdapter) value() reflect.Value {
+	return reflect.Indirect(reflect.ValueOf(a.src))
+}
+
+func (a *MapAdapter) sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return a.less(keys[i].Interface(), keys[j].Interface())
+	})
+
+	return keys
+}
+
+// lessKeys default map key ordering, a total order even for keys of mixed kinds.
+// The keys are ordered by kind first: booleans, integers, floats, strings and then any other key;
+// within a kind by value, any other key by its type and its string form.
+func lessKeys(a, b interface{}) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if ra, rb := keyRank(va), keyRank(vb); ra != rb {
+		return ra < rb
+	}
+
+	switch keyRank(va) {
+	case rankNil:
+		return false
+	case rankBool:
+		return !va.Bool() && vb.Bool()
+	case rankInt:
+		return lessInts(va, vb)
+	case rankFloat:
+		fa, fb := va.Float(), vb.Float()
+		// NaN goes first, so the floats are totally ordered too
+		if math.IsNaN(fa) || math.IsNaN(fb) {
+			return math.IsNaN(fa) && !math.IsNaN(fb)
+		}
+
+		return fa < fb
+	case rankString:
+		return va.String() < vb.String()
+	}
+
+	if ta, tb := va.Type().String(), vb.Type().String(); ta != tb {
+		return ta < tb
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// key kinds, in their order
+const (
+	rankNil = iota
+	rankBool
+	rankInt
+	rankFloat
+	rankString
+	rankOther
+)
+
+func keyRank(v reflect.Value) int {
+	if !v.IsValid() {
+		return rankNil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return rankBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rankInt
+	case reflect.Float32, reflect.Float64:
+		return rankFloat
+	case reflect.String:
+		return rankString
+	}
+
+	return rankOther
+}
+
+// lessInts compares signed and unsigned integers exactly
+func lessInts(a, b reflect.Value) bool {
+	signedA, signedB := a.Kind() <= reflect.Int64, b.Kind() <= reflect.Int64
+	switch {
+	case signedA && signedB:
+		return a.Int() < b.Int()
+	case !signedA && !signedB:
+		return a.Uint() < b.Uint()
+	case signedA:
+		return a.Int() < 0 || uint64(a.Int()) < b.Uint()
+	default:
+		return b.Int() >= 0 && a.Uint() < uint64(b.Int())
+	}
+}
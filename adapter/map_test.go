@@ -0,0 +1,154 @@
+package adapter_test
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"testing"
+)
+
+type MapAdapterTestSuite struct {
+	suite.Suite
+	data map[string]int
+}
+
+func (suite *MapAdapterTestSuite) SetupTest() {
+	suite.data = make(map[string]int, 100)
+	for i := 1; i <= 100; i++ {
+		suite.data[fmt.Sprintf("key-%03d", i)] = i
+	}
+}
+
+func (suite *MapAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(adapter.NewMapAdapter(suite.data), 10)
+
+	require := suite.Require()
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	hn, err := p.HasNext()
+	require.NoError(err)
+	require.True(hn)
+
+	hp, err := p.HasPrev()
+	require.NoError(err)
+	require.False(hp)
+}
+
+func (suite *MapAdapterTestSuite) TestCurrentPageResults() {
+	p := paginator.New(adapter.NewMapAdapter(suite.data), 10)
+
+	require := suite.Require()
+	var values []int
+	p.SetPage(6)
+
+	require.NoError(p.Results(&values))
+	require.Len(values, 10)
+	for i, value := range values {
+		require.Equal(51+i, value)
+	}
+}
+
+func (suite *MapAdapterTestSuite) TestKeyValueResults() {
+	p := paginator.New(adapter.NewMapAdapter(suite.data), 10)
+
+	require := suite.Require()
+	var entries []adapter.KeyValue
+	p.SetPage(10)
+
+	require.NoError(p.Results(&entries))
+	require.Len(entries, 10)
+	require.Equal(adapter.KeyValue{Key: "key-091", Value: 91}, entries[0])
+	require.Equal(adapter.KeyValue{Key: "key-100", Value: 100}, entries[9])
+}
+
+func (suite *MapAdapterTestSuite) TestDeterministicOrder() {
+	a := adapter.NewMapAdapter(suite.data)
+
+	require := suite.Require()
+	var first []int
+	require.NoError(a.Slice(0, 100, &first))
+	for i := 0; i < 10; i++ {
+		var values []int
+		require.NoError(a.Slice(0, 100, &values))
+		require.Equal(first, values)
+	}
+}
+
+func (suite *MapAdapterTestSuite) TestNumericKeys() {
+	data := map[int]string{10: "ten", 2: "two", 33: "thirty-three", -1: "minus one"}
+	a := adapter.NewMapAdapter(data)
+
+	require := suite.Require()
+	var values []string
+	require.NoError(a.Slice(0, 10, &values))
+	require.Equal([]string{"minus one", "two", "ten", "thirty-three"}, values)
+}
+
+func (suite *MapAdapterTestSuite) TestMixedKeys() {
+	data := map[interface{}]int{
+		9: 1, 10: 2, "5": 3, "a": 4, int8(-3): 5, uint(7): 6, 2.5: 7, true: 9, false: 10,
+		[2]int{1, 2}: 11, struct{}{}: 12, nil: 13,
+	}
+	a := adapter.NewMapAdapter(data)
+
+	require := suite.Require()
+	var first []int
+	require.NoError(a.Slice(0, 20, &first))
+	require.Equal([]int{13, 10, 9, 5, 6, 1, 2, 7, 3, 4, 11, 12}, first)
+
+	for i := 0; i < 200; i++ {
+		var values []int
+		require.NoError(a.Slice(0, 20, &values))
+		require.Equal(first, values)
+	}
+}
+
+func (suite *MapAdapterTestSuite) TestCustomLess() {
+	a := adapter.NewMapAdapterWithLess(suite.data, func(a, b interface{}) bool {
+		return a.(string) > b.(string)
+	})
+
+	require := suite.Require()
+	var values []int
+	require.NoError(a.Slice(95, 10, &values))
+	require.Equal([]int{5, 4, 3, 2, 1}, values)
+}
+
+func (suite *MapAdapterTestSuite) TestInvalidSource() {
+	p := paginator.New(adapter.NewMapAdapter([]int{1, 2}), 10)
+
+	require := suite.Require()
+	_, err := p.Nums()
+	require.EqualError(err, "expected map but got []int")
+
+	var values []int
+	require.EqualError(p.Results(&values), "expected map but got []int")
+}
+
+func (suite *MapAdapterTestSuite) TestInterfaceValues() {
+	a := adapter.NewMapAdapter(map[string]interface{}{"a": nil, "b": 2, "c": "three"})
+
+	require := suite.Require()
+	var values []interface{}
+	require.NoError(a.Slice(0, 10, &values))
+	require.Equal([]interface{}{nil, 2, "three"}, values)
+
+	var numbers []int
+	require.NoError(a.Slice(1, 1, &numbers))
+	require.Equal([]int{2}, numbers)
+	require.EqualError(a.Slice(0, 1, &numbers), "key a: expected int but got nil")
+	require.EqualError(a.Slice(2, 1, &numbers), "key c: expected int but got string")
+
+	// the values aren't converted between numeric types
+	var floats []float64
+	require.EqualError(adapter.NewMapAdapter(map[string]int{"a": 1}).Slice(0, 1, &floats), "key a: expected float64 but got int")
+	require.EqualError(adapter.NewMapAdapter(map[string]float64{"a": 1.9}).Slice(0, 1, &numbers), "key a: expected int but got float64")
+	require.EqualError(adapter.NewMapAdapter(map[string]interface{}{"a": 1.9}).Slice(0, 1, &numbers), "key a: expected int but got float64")
+}
+
+func TestMapAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(MapAdapterTestSuite))
+}
@@ -0,0 +1,166 @@
+package adapter
+
+import (
+	"errors"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+	"sync"
+)
+
+var errMergeUnknownNums = errors.New("merge: the adapters must count their items")
+
+type (
+	// MergeAdapter adapter to be passed to paginator constructor to paginate the results of several sorted adapters,
+	// e.g. the shards of a federated search, as a single sorted list.
+	MergeAdapter struct {
+		adapters []paginator.Adapter
+		less     func(a, b interface{}) bool
+
+		mu sync.Mutex
+		// cursors offsets of the adapters' next items at given merged offsets
+		cursors map[int][]int
+	}
+
+	// mergeHead the items read ahead from an adapter
+	mergeHead struct {
+		buf    reflect.Value
+		offset int
+		i      int
+		done   bool
+	}
+)
+
+// NewMergeAdapter merge adapter constructor receive the less function of items and the adapters to be merged.
+// Each adapter must already be sorted by the same less function.
+// The items which are equal are ordered by the position of their adapter.
+// A nil less orders the items like the keys of NewMapAdapter: by kind, then by value.
+func NewMergeAdapter(less func(a, b interface{}) bool, adapters ...paginator.Adapter) paginator.Adapter {
+	if less == nil {
+		less = lessKeys
+	}
+
+	return &MergeAdapter{adapters: adapters, less: less, cursors: make(map[int][]int)}
+}
+
+// Nums returns the sum of the number of items of the adapters
+func (a *MergeAdapter) Nums() (int64, error) {
+	var total int64
+	for _, child := range a.adapters {
+		n, err := child.Nums()
+		if err != nil {
+			return 0, err
+		}
+
+		if n == paginator.UnknownNums {
+			return 0, errMergeUnknownNums
+		}
+
+		total += n
+	}
+
+	return total, nil
+}
+
+// Slice stores into dest argument a slice of the merged results.
+// The adapters are read in batches of length items, only as far as needed for the requested range,
+// starting from the closest previously reached offset.
+// dest argument must be a pointer to a slice
+func (a *MergeAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, length); err != nil {
+		return err
+	}
+
+	if length <= 0 {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	vt := reflect.ValueOf(dest).Elem()
+	pos, heads := a.cursor(offset)
+	for pos < offset+length {
+		best := -1
+		for i := range heads {
+			ok, err := a.peek(i, &heads[i], length, vt.Type())
+			if err != nil {
+				return err
+			}
+
+			if ok && (best == -1 || a.less(heads[i].item().Interface(), heads[best].item().Interface())) {
+				best = i
+			}
+		}
+
+		if best == -1 {
+			break
+		}
+
+		if pos >= offset {
+			vt.Set(reflect.Append(vt, heads[best].item()))
+		}
+
+		heads[best].i++
+		pos++
+		if pos == offset || pos == offset+length {
+			a.cursors[pos] = mergeCursor(heads)
+		}
+	}
+
+	return nil
+}
+
+// cursor returns the closest known merged offset before offset and the adapters' heads at that offset
+func (a *MergeAdapter) cursor(offset int) (int, []mergeHead) {
+	pos := 0
+	heads := make([]mergeHead, len(a.adapters))
+	for reached, cursor := range a.cursors {
+		if reached <= offset && reached > pos {
+			pos = reached
+			for i := range heads {
+				heads[i] = mergeHead{offset: cursor[i]}
+			}
+		}
+	}
+
+	return pos, heads
+}
+
+// peek reads the next batch of the i-th adapter if needed and returns false if it has no more items
+func (a *MergeAdapter) peek(i int, h *mergeHead, batch int, typ reflect.Type) (bool, error) {
+	if h.buf.IsValid() && h.i < h.buf.Len() {
+		return true, nil
+	}
+
+	if h.done {
+		return false, nil
+	}
+
+	if h.buf.IsValid() {
+		h.offset += h.buf.Len()
+	}
+
+	buf := reflect.New(typ)
+	if err := a.adapters[i].Slice(h.offset, batch, buf.Interface()); err != nil {
+		return false, err
+	}
+
+	h.buf, h.i = buf.Elem(), 0
+	h.done = h.buf.Len() < batch
+
+	return h.buf.Len() > 0, nil
+}
+
+func (h *mergeHead) item() reflect.Value {
+	return h.buf.Index(h.i)
+}
+
+// mergeCursor returns the offsets of the adapters' next items
+func mergeCursor(heads []mergeHead) []int {
+	cursor := make([]int, len(heads))
+	for i, h := range heads {
+		cursor[i] = h.offset + h.i
+	}
+
+	return cursor
+}
@@ -0,0 +1,161 @@
+package adapter_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"testing"
+)
+
+type MergeAdapterTestSuite struct {
+	suite.Suite
+	shards []*spyAdapter
+}
+
+// SetupTest creates three shards holding the multiples of 2, the multiples of 3 and the numbers from 50 to 59
+func (suite *MergeAdapterTestSuite) SetupTest() {
+	var twos, threes, fifties []int
+	for i := 1; i <= 100; i++ {
+		if i%2 == 0 {
+			twos = append(twos, i)
+		}
+
+		if i%3 == 0 {
+			threes = append(threes, i)
+		}
+
+		if i >= 50 && i < 60 {
+			fifties = append(fifties, i)
+		}
+	}
+
+	suite.shards = []*spyAdapter{
+		{Adapter: adapter.NewSliceAdapter(twos)},
+		{Adapter: adapter.NewSliceAdapter(threes)},
+		{Adapter: adapter.NewSliceAdapter(fifties)},
+	}
+}
+
+func (suite *MergeAdapterTestSuite) newAdapter() paginator.Adapter {
+	return adapter.NewMergeAdapter(func(a, b interface{}) bool {
+		return a.(int) < b.(int)
+	}, suite.shards[0], suite.shards[1], suite.shards[2])
+}
+
+// expected returns the merged items from offset
+func (suite *MergeAdapterTestSuite) expected(offset, length int) []int {
+	var items []int
+	for i := 1; i <= 100; i++ {
+		for _, matches := range []bool{i%2 == 0, i%3 == 0, i >= 50 && i < 60} {
+			if matches {
+				items = append(items, i)
+			}
+		}
+	}
+
+	if offset > len(items) {
+		offset = len(items)
+	}
+
+	if offset+length > len(items) {
+		length = len(items) - offset
+	}
+
+	return items[offset : offset+length]
+}
+
+func (suite *MergeAdapterTestSuite) TestFirstPage() {
+	p := paginator.New(suite.newAdapter(), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(93, n)
+
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{2, 3, 4, 6, 6, 8, 9, 10, 12, 12}, items)
+
+	// each shard is read only as far as needed
+	require.Equal([][2]int{{0, 10}}, suite.shards[0].slices)
+	require.Equal([][2]int{{0, 10}}, suite.shards[1].slices)
+	require.Equal([][2]int{{0, 10}}, suite.shards[2].slices)
+}
+
+func (suite *MergeAdapterTestSuite) TestAllPages() {
+	p := paginator.New(suite.newAdapter(), 7)
+
+	require := suite.Require()
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(14, pn)
+
+	for page := 1; page <= pn; page++ {
+		p.SetPage(page)
+
+		var items []int
+		require.NoError(p.Results(&items))
+		require.Equal(suite.expected((page-1)*7, 7), items, "page %d", page)
+	}
+}
+
+func (suite *MergeAdapterTestSuite) TestCursors() {
+	a := suite.newAdapter()
+
+	require := suite.Require()
+	var items []int
+	require.NoError(a.Slice(0, 10, &items))
+
+	// the next page resumes from where the previous one ended
+	for _, shard := range suite.shards {
+		shard.slices = nil
+	}
+
+	require.NoError(a.Slice(10, 10, &items))
+	require.Equal(suite.expected(10, 10), items)
+	require.Equal([][2]int{{6, 10}}, suite.shards[0].slices)
+	require.Equal([][2]int{{4, 10}}, suite.shards[1].slices)
+	require.Equal([][2]int{{0, 10}}, suite.shards[2].slices)
+
+	// a random page is read from the start
+	require.NoError(suite.newAdapter().Slice(45, 10, &items))
+	require.Equal(suite.expected(45, 10), items)
+}
+
+func (suite *MergeAdapterTestSuite) TestOffsetBeyondEnd() {
+	var items []int
+	require := suite.Require()
+	require.NoError(suite.newAdapter().Slice(90, 10, &items))
+	require.Equal([]int{98, 99, 100}, items)
+
+	require.NoError(suite.newAdapter().Slice(200, 10, &items))
+	require.Empty(items)
+}
+
+func (suite *MergeAdapterTestSuite) TestDefaultLess() {
+	a := adapter.NewMergeAdapter(nil, adapter.NewSliceAdapter([]string{"b", "d"}), adapter.NewSliceAdapter([]string{"a", "c", "e"}))
+
+	var items []string
+	suite.Require().NoError(a.Slice(1, 3, &items))
+	suite.Require().Equal([]string{"b", "c", "d"}, items)
+}
+
+func (suite *MergeAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	var items []int
+	err := suite.newAdapter().Slice(0, 10, items)
+	require.EqualError(err, "expected to be a ptr but got []int")
+
+	a := adapter.NewMergeAdapter(nil, suite.shards[0], adapter.NewSliceAdapter(nil))
+	_, err = a.Nums()
+	require.EqualError(err, "expected slice but got nil")
+	require.EqualError(a.Slice(0, 10, &items), "expected slice but got nil")
+
+	_, err = adapter.NewMergeAdapter(nil, suite.shards[0], adapter.NewChanAdapter(make(chan int))).Nums()
+	require.EqualError(err, "merge: the adapters must count their items")
+}
+
+func TestMergeAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(MergeAdapterTestSuite))
+}
@@ -0,0 +1,186 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+)
+
+var zType = reflect.TypeOf(redis.Z{})
+
+type (
+	// RedisZSetOptions sorted set pagination options
+	RedisZSetOptions struct {
+		// Rev orders the members from the highest to the lowest score
+		Rev bool
+		// Min paginates only the members with a score greater than or equal to Min, e.g. "10", "(10" or "-inf"
+		Min string
+		// Max paginates only the members with a score less than or equal to Max, e.g. "20", "(20" or "+inf"
+		Max string
+		// Codec decodes the members stored into a slice of neither strings nor redis.Z, JSONCodec by default
+		Codec Codec
+	}
+
+	// RedisListOptions list pagination options
+	RedisListOptions struct {
+		// Codec decodes the elements stored into a slice of non strings, JSONCodec by default
+		Codec Codec
+	}
+
+	// RedisZSetAdapter redis adapter to be passed to paginator constructor to paginate a sorted set.
+	RedisZSetAdapter struct {
+		ctx    context.Context
+		client redis.Cmdable
+		key    string
+		opts   RedisZSetOptions
+	}
+
+	// RedisListAdapter redis adapter to be passed to paginator constructor to paginate a list.
+	RedisListAdapter struct {
+		ctx    context.Context
+		client redis.Cmdable
+		key    string
+		opts   RedisListOptions
+	}
+)
+
+// NewRedisZSetAdapter sorted set adapter constructor receive the redis client and the key of the sorted set.
+func NewRedisZSetAdapter(ctx context.Context, client redis.Cmdable, key string, opts RedisZSetOptions) paginator.Adapter {
+	if opts.Codec == nil {
+		opts.Codec = JSONCodec
+	}
+
+	return &RedisZSetAdapter{ctx: ctx, client: client, key: key, opts: opts}
+}
+
+// Nums returns the number of members within the score range
+func (a *RedisZSetAdapter) Nums() (int64, error) {
+	if a.byScore() {
+		return a.client.ZCount(a.ctx, a.key, a.min(), a.max()).Result()
+	}
+
+	return a.client.ZCard(a.ctx, a.key).Result()
+}
+
+// Slice stores into dest argument a slice of the members.
+// dest argument must be a pointer to a slice of strings, a pointer to a slice of redis.Z to get the scores too
+// or a pointer to a slice of any type the members are decoded into.
+func (a *RedisZSetAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	if length <= 0 {
+		return nil
+	}
+
+	var (
+		zs  []redis.Z
+		err error
+	)
+
+	start, stop := int64(offset), int64(offset+length-1)
+	switch {
+	case a.byScore():
+		by := &redis.ZRangeBy{Min: a.min(), Max: a.max(), Offset: int64(offset), Count: int64(length)}
+		if a.opts.Rev {
+			zs, err = a.client.ZRevRangeByScoreWithScores(a.ctx, a.key, by).Result()
+		} else {
+			zs, err = a.client.ZRangeByScoreWithScores(a.ctx, a.key, by).Result()
+		}
+	case a.opts.Rev:
+		zs, err = a.client.ZRevRangeWithScores(a.ctx, a.key, start, stop).Result()
+	default:
+		zs, err = a.client.ZRangeWithScores(a.ctx, a.key, start, stop).Result()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	if vt.Type().Elem() == zType {
+		vt.Set(reflect.ValueOf(zs))
+
+		return nil
+	}
+
+	members := make([]string, len(zs))
+	for i, z := range zs {
+		members[i] = fmt.Sprint(z.Member)
+	}
+
+	return decodeStrings(members, vt, a.opts.Codec)
+}
+
+func (a *RedisZSetAdapter) byScore() bool {
+	return a.opts.Min != "" || a.opts.Max != ""
+}
+
+func (a *RedisZSetAdapter) min() string {
+	if a.opts.Min == "" {
+		return "-inf"
+	}
+
+	return a.opts.Min
+}
+
+func (a *RedisZSetAdapter) max() string {
+	if a.opts.Max == "" {
+		return "+inf"
+	}
+
+	return a.opts.Max
+}
+
+// NewRedisListAdapter list adapter constructor receive the redis client and the key of the list.
+func NewRedisListAdapter(ctx context.Context, client redis.Cmdable, key string, opts RedisListOptions) paginator.Adapter {
+	if opts.Codec == nil {
+		opts.Codec = JSONCodec
+	}
+
+	return &RedisListAdapter{ctx: ctx, client: client, key: key, opts: opts}
+}
+
+// Nums returns the length of the list
+func (a *RedisListAdapter) Nums() (int64, error) {
+	return a.client.LLen(a.ctx, a.key).Result()
+}
+
+// Slice stores into dest argument a slice of the list elements.
+// dest argument must be a pointer to a slice of strings or a pointer to a slice of any type the elements are decoded into.
+func (a *RedisListAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	if length <= 0 {
+		return nil
+	}
+
+	elems, err := a.client.LRange(a.ctx, a.key, int64(offset), int64(offset+length-1)).Result()
+	if err != nil {
+		return err
+	}
+
+	return decodeStrings(elems, reflect.ValueOf(dest).Elem(), a.opts.Codec)
+}
+
+// decodeStrings stores the strings into the slice vt, decoding them with codec unless vt is a slice of strings
+func decodeStrings(s []string, vt reflect.Value, codec Codec) error {
+	vt.Set(reflect.MakeSlice(vt.Type(), len(s), len(s)))
+	for i, v := range s {
+		if vt.Type().Elem().Kind() == reflect.String {
+			vt.Index(i).SetString(v)
+			continue
+		}
+
+		if err := codec.Unmarshal([]byte(v), vt.Index(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("redis: element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
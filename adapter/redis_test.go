@@ -0,0 +1,198 @@
+package adapter_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"testing"
+)
+
+type RedisAdapterTestSuite struct {
+	suite.Suite
+	server *miniredis.Miniredis
+	client *redis.Client
+	ctx    context.Context
+}
+
+func (suite *RedisAdapterTestSuite) SetupTest() {
+	require := suite.Require()
+
+	server, err := miniredis.Run()
+	require.NoError(err)
+
+	suite.server = server
+	suite.client = redis.NewClient(&redis.Options{Addr: server.Addr()})
+	suite.ctx = context.Background()
+
+	for i := 1; i <= 100; i++ {
+		_, err := suite.server.ZAdd("leaderboard", float64(i*10), fmt.Sprintf("player-%03d", i))
+		require.NoError(err)
+
+		data, err := json.Marshal(Post{ID: uint(i), Number: i})
+		require.NoError(err)
+
+		_, err = suite.server.Push("posts", string(data))
+		require.NoError(err)
+	}
+}
+
+func (suite *RedisAdapterTestSuite) TearDownTest() {
+	suite.Require().NoError(suite.client.Close())
+	suite.server.Close()
+}
+
+func (suite *RedisAdapterTestSuite) TestZSetFirstPage() {
+	p := paginator.New(adapter.NewRedisZSetAdapter(suite.ctx, suite.client, "leaderboard", adapter.RedisZSetOptions{}), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	var members []string
+	require.NoError(p.Results(&members))
+	require.Len(members, 10)
+	require.Equal("player-001", members[0])
+	require.Equal("player-010", members[9])
+}
+
+func (suite *RedisAdapterTestSuite) TestZSetRevWithScores() {
+	p := paginator.New(adapter.NewRedisZSetAdapter(suite.ctx, suite.client, "leaderboard", adapter.RedisZSetOptions{
+		Rev: true,
+	}), 10)
+	p.SetPage(10)
+
+	require := suite.Require()
+	var zs []redis.Z
+	require.NoError(p.Results(&zs))
+	require.Len(zs, 10)
+	require.Equal(redis.Z{Score: 100, Member: "player-010"}, zs[0])
+	require.Equal(redis.Z{Score: 10, Member: "player-001"}, zs[9])
+}
+
+func (suite *RedisAdapterTestSuite) TestZSetScoreRange() {
+	tests := []struct {
+		name     string
+		opts     adapter.RedisZSetOptions
+		nums     int64
+		expected []string
+	}{
+		{
+			name:     "min",
+			opts:     adapter.RedisZSetOptions{Min: "955"},
+			nums:     5,
+			expected: []string{"player-096", "player-097", "player-098"},
+		},
+		{
+			name:     "exclusive max rev",
+			opts:     adapter.RedisZSetOptions{Max: "(50", Rev: true},
+			nums:     4,
+			expected: []string{"player-004", "player-003", "player-002"},
+		},
+		{
+			name:     "range",
+			opts:     adapter.RedisZSetOptions{Min: "100", Max: "110"},
+			nums:     2,
+			expected: []string{"player-010", "player-011"},
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			require := suite.Require()
+			p := paginator.New(adapter.NewRedisZSetAdapter(suite.ctx, suite.client, "leaderboard", test.opts), 3)
+
+			n, err := p.Nums()
+			require.NoError(err)
+			require.Equal(test.nums, n)
+
+			var members []string
+			require.NoError(p.Results(&members))
+			require.Equal(test.expected, members)
+		})
+	}
+}
+
+func (suite *RedisAdapterTestSuite) TestZSetScoreRangeLastPage() {
+	p := paginator.New(adapter.NewRedisZSetAdapter(suite.ctx, suite.client, "leaderboard", adapter.RedisZSetOptions{
+		Min: "955",
+	}), 3)
+	p.SetPage(2)
+
+	require := suite.Require()
+	var members []string
+	require.NoError(p.Results(&members))
+	require.Equal([]string{"player-099", "player-100"}, members)
+}
+
+func (suite *RedisAdapterTestSuite) TestListPages() {
+	p := paginator.New(adapter.NewRedisListAdapter(suite.ctx, suite.client, "posts", adapter.RedisListOptions{}), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	var posts []Post
+	p.SetPage(6)
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(51 + i), Number: 51 + i}, post)
+	}
+
+	var raw []string
+	p.SetPage(10)
+	require.NoError(p.Results(&raw))
+	require.Len(raw, 10)
+	require.Equal(`{"ID":100,"Number":100}`, raw[9])
+}
+
+func (suite *RedisAdapterTestSuite) TestMissingKey() {
+	require := suite.Require()
+	for _, a := range []paginator.Adapter{
+		adapter.NewRedisZSetAdapter(suite.ctx, suite.client, "missing", adapter.RedisZSetOptions{}),
+		adapter.NewRedisListAdapter(suite.ctx, suite.client, "missing", adapter.RedisListOptions{}),
+	} {
+		p := paginator.New(a, 10)
+
+		n, err := p.Nums()
+		require.NoError(err)
+		require.EqualValues(0, n)
+
+		var items []string
+		require.NoError(p.Results(&items))
+		require.Empty(items)
+	}
+}
+
+func (suite *RedisAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	// wrong type
+	_, err := adapter.NewRedisListAdapter(suite.ctx, suite.client, "leaderboard", adapter.RedisListOptions{}).Nums()
+	require.Error(err)
+
+	var posts []Post
+	err = adapter.NewRedisZSetAdapter(suite.ctx, suite.client, "leaderboard", adapter.RedisZSetOptions{}).
+		Slice(0, 10, &posts)
+	require.Error(err)
+	require.Contains(err.Error(), "redis: element 0")
+
+	suite.server.Close()
+	_, err = adapter.NewRedisZSetAdapter(suite.ctx, suite.client, "leaderboard", adapter.RedisZSetOptions{}).Nums()
+	require.Error(err)
+}
+
+func TestRedisAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisAdapterTestSuite))
+}
@@ -0,0 +1,311 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DefaultUpstreamPageSize default number of items requested from the upstream service at once
+const DefaultUpstreamPageSize = 100
+
+// ErrTotalNotFound the upstream response doesn't contain the total number of items
+var ErrTotalNotFound = errors.New("rest: total number of items not found")
+
+type (
+	// RESTOptions upstream API options.
+	// Set either PageParam for APIs paginated by page number or OffsetParam for APIs paginated by offset.
+	RESTOptions struct {
+		// URL of the list endpoint, it can have its own query string
+		URL string
+		// Client the HTTP client, http.DefaultClient by default
+		Client *http.Client
+		// Header is added to every request, e.g. for authentication
+		Header http.Header
+
+		// PageParam the page number query parameter name, e.g. "page"
+		PageParam string
+		// PerPageParam the page size query parameter name, e.g. "per_page"
+		PerPageParam string
+		// ZeroBasedPages the first upstream page is 0 instead of 1
+		ZeroBasedPages bool
+		// OffsetParam the offset query parameter name, e.g. "offset"
+		OffsetParam string
+		// LimitParam the limit query parameter name, e.g. "limit"
+		LimitParam string
+		// PageSize the number of items requested at once, DefaultUpstreamPageSize by default.
+		// The upstream pages are re-chunked into the pages of the paginator.
+		PageSize int
+
+		// TotalHeader the response header holding the total number of items, e.g. "X-Total-Count"
+		TotalHeader string
+		// TotalPath the dotted path of the total number of items in the response body, e.g. "meta.total"
+		TotalPath string
+		// ItemsPath the dotted path of the items array in the response body, e.g. "data".
+		// An empty path means the body is the items array.
+		ItemsPath string
+	}
+
+	// RESTAdapter adapter to be passed to paginator constructor to paginate the lists of an upstream HTTP service.
+	RESTAdapter struct {
+		ctx  context.Context
+		opts RESTOptions
+		err  error
+	}
+)
+
+// NewRESTAdapter rest adapter constructor receive the upstream API options.
+// Exactly one of PageParam and OffsetParam must be set.
+func NewRESTAdapter(ctx context.Context, opts RESTOptions) paginator.Adapter {
+	switch {
+	case opts.PageParam == "" && opts.OffsetParam == "":
+		return &RESTAdapter{err: errors.New("rest: expected PageParam or OffsetParam but got none")}
+	case opts.PageParam != "" && opts.OffsetParam != "":
+		return &RESTAdapter{err: errors.New("rest: expected PageParam or OffsetParam but got both")}
+	}
+
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultUpstreamPageSize
+	}
+
+	return &RESTAdapter{ctx: ctx, opts: opts}
+}
+
+// Nums returns the total number of items reported by the upstream service
+func (a *RESTAdapter) Nums() (int64, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+
+	header, body, err := a.fetch(0)
+	if err != nil {
+		return 0, err
+	}
+
+	if a.opts.TotalHeader != "" {
+		if v := header.Get(a.opts.TotalHeader); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("rest: header %s: %w", a.opts.TotalHeader, err)
+			}
+
+			return n, nil
+		}
+	}
+
+	if a.opts.TotalPath != "" {
+		raw, err := jsonPath(body, a.opts.TotalPath)
+		if err != nil {
+			return 0, err
+		}
+
+		if raw != nil {
+			var n int64
+			if err := json.Unmarshal(raw, &n); err != nil {
+				return 0, fmt.Errorf("rest: %s: %w", a.opts.TotalPath, err)
+			}
+
+			return n, nil
+		}
+	}
+
+	return 0, ErrTotalNotFound
+}
+
+// Slice stores into dest argument a slice of the decoded items.
+// dest argument must be a pointer to a slice
+func (a *RESTAdapter) Slice(offset, length int, dest interface{}) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	// APIs paginated by page number must be requested from the start of an upstream page
+	start := offset
+	if a.opts.PageParam != "" {
+		start -= offset % a.opts.PageSize
+	}
+
+	items := make([]json.RawMessage, 0, length)
+	for pos := start; pos < offset+length; {
+		page, err := a.page(pos)
+		if err != nil {
+			return err
+		}
+
+		for i, item := range page {
+			if n := pos + i; n >= offset && n < offset+length {
+				items = append(items, item)
+			}
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		// the upstream may return fewer items than the limit, e.g. when it caps it, so resume after the last one
+		if a.opts.PageParam == "" {
+			pos += len(page)
+			continue
+		}
+
+		if len(page) < a.opts.PageSize {
+			// a short page is the last one, unless the upstream caps the page size
+			// and the page numbers no longer match the offsets
+			if pos+len(page) < offset+length {
+				next, err := a.page(pos + a.opts.PageSize)
+				if err != nil {
+					return err
+				}
+
+				if len(next) > 0 {
+					return fmt.Errorf("rest: upstream page of %d items instead of %d, lower PageSize", len(page), a.opts.PageSize)
+				}
+			}
+
+			break
+		}
+
+		pos += a.opts.PageSize
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	vt.Set(reflect.MakeSlice(vt.Type(), len(items), len(items)))
+	for i, item := range items {
+		if err := json.Unmarshal(item, vt.Index(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("rest: item %d: %w", offset+i, err)
+		}
+	}
+
+	return nil
+}
+
+// page returns the upstream items starting at offset
+func (a *RESTAdapter) page(offset int) ([]json.RawMessage, error) {
+	_, body, err := a.fetch(offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.items(body)
+}
+
+// fetch requests the upstream items starting at offset
+func (a *RESTAdapter) fetch(offset int) (http.Header, []byte, error) {
+	u, err := url.Parse(a.opts.URL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := u.Query()
+	if a.opts.PageParam != "" {
+		page := offset / a.opts.PageSize
+		if !a.opts.ZeroBasedPages {
+			page++
+		}
+
+		q.Set(a.opts.PageParam, strconv.Itoa(page))
+		if a.opts.PerPageParam != "" {
+			q.Set(a.opts.PerPageParam, strconv.Itoa(a.opts.PageSize))
+		}
+	}
+
+	if a.opts.OffsetParam != "" {
+		q.Set(a.opts.OffsetParam, strconv.Itoa(offset))
+	}
+
+	if a.opts.LimitParam != "" {
+		q.Set(a.opts.LimitParam, strconv.Itoa(a.opts.PageSize))
+	}
+
+	u.RawQuery = q.Encode()
+
+	return httpGet(a.ctx, a.opts.Client, u.String(), a.opts.Header)
+}
+
+// items returns the items array of the response body
+func (a *RESTAdapter) items(body []byte) ([]json.RawMessage, error) {
+	raw, err := jsonPath(body, a.opts.ItemsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []json.RawMessage
+	if raw == nil {
+		return items, nil
+	}
+
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("rest: items: %w", err)
+	}
+
+	return items, nil
+}
+
+// httpGet returns the headers and the body of a successful GET request
+func httpGet(ctx context.Context, client *http.Client, u string, header http.Header) (http.Header, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, nil, fmt.Errorf("rest: GET %s: %s", u, res.Status)
+	}
+
+	return res.Header, body, nil
+}
+
+// jsonPath returns the raw value at the dotted path of the JSON document, or nil if there is no such value
+func jsonPath(data []byte, path string) (json.RawMessage, error) {
+	raw := json.RawMessage(data)
+	if path == "" {
+		return raw, nil
+	}
+
+	for _, name := range strings.Split(path, ".") {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, fmt.Errorf("rest: %s: %w", path, err)
+		}
+
+		var ok bool
+		if raw, ok = obj[name]; !ok {
+			return nil, nil
+		}
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,269 @@
+package adapter_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+type RESTAdapterTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []url.Values
+}
+
+// SetupTest starts an upstream service listing 100 posts on two endpoints:
+// /pages?page=&per_page= with the total in the X-Total-Count header and the items as the body
+// /offsets?offset=&limit= with the total and the items in the body, at most 7 items at once
+func (suite *RESTAdapterTestSuite) SetupTest() {
+	suite.requests = nil
+	posts := func(from, n int) []Post {
+		items := make([]Post, 0)
+		for i := from; i < from+n && i < 100; i++ {
+			items = append(items, Post{ID: uint(i + 1), Number: i + 1})
+		}
+
+		return items
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pages", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		suite.requests = append(suite.requests, q)
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		page, _ := strconv.Atoi(q.Get("page"))
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+		w.Header().Set("X-Total-Count", "100")
+		_ = json.NewEncoder(w).Encode(posts((page-1)*perPage, perPage))
+	})
+	// caps the page size at 5
+	mux.HandleFunc("/capped-pages", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		suite.requests = append(suite.requests, q)
+
+		page, _ := strconv.Atoi(q.Get("page"))
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+		if perPage > 5 {
+			perPage = 5
+		}
+
+		_ = json.NewEncoder(w).Encode(posts((page-1)*perPage, perPage))
+	})
+	mux.HandleFunc("/offsets", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		suite.requests = append(suite.requests, q)
+
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if limit > 7 {
+			limit = 7
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"meta": map[string]interface{}{"total": 100},
+			"data": posts(offset, limit),
+		})
+	})
+
+	suite.server = httptest.NewServer(mux)
+}
+
+func (suite *RESTAdapterTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *RESTAdapterTestSuite) pagesAdapter(pageSize int) paginator.Adapter {
+	return adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:          suite.server.URL + "/pages",
+		Header:       http.Header{"Authorization": {"Bearer token"}},
+		PageParam:    "page",
+		PerPageParam: "per_page",
+		PageSize:     pageSize,
+		TotalHeader:  "X-Total-Count",
+	})
+}
+
+func (suite *RESTAdapterTestSuite) offsetsAdapter() paginator.Adapter {
+	return adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:         suite.server.URL + "/offsets?sort=number",
+		OffsetParam: "offset",
+		LimitParam:  "limit",
+		PageSize:    7,
+		TotalPath:   "meta.total",
+		ItemsPath:   "data",
+	})
+}
+
+func (suite *RESTAdapterTestSuite) TestPagesFirstPage() {
+	p := paginator.New(suite.pagesAdapter(25), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	require.Equal(Post{ID: 1, Number: 1}, posts[0])
+}
+
+func (suite *RESTAdapterTestSuite) TestPagesRechunking() {
+	a := suite.pagesAdapter(25)
+
+	require := suite.Require()
+	var posts []Post
+	suite.requests = nil
+	require.NoError(a.Slice(20, 10, &posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(21 + i), Number: 21 + i}, post)
+	}
+
+	require.Len(suite.requests, 2)
+	require.Equal("1", suite.requests[0].Get("page"))
+	require.Equal("2", suite.requests[1].Get("page"))
+	require.Equal("25", suite.requests[1].Get("per_page"))
+}
+
+func (suite *RESTAdapterTestSuite) TestOffsetsRechunking() {
+	p := paginator.New(suite.offsetsAdapter(), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(100, n)
+
+	var posts []Post
+	p.SetPage(10)
+	suite.requests = nil
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(91 + i), Number: 91 + i}, post)
+	}
+
+	// the paginator counts the posts again before reading the page
+	require.Len(suite.requests, 3)
+	require.Equal("0", suite.requests[0].Get("offset"))
+	require.Equal("90", suite.requests[1].Get("offset"))
+	require.Equal("97", suite.requests[2].Get("offset"))
+	require.Equal("number", suite.requests[2].Get("sort"))
+}
+
+func (suite *RESTAdapterTestSuite) TestCappedUpstream() {
+	require := suite.Require()
+
+	// the offsets upstream caps the limit at 7
+	var posts []Post
+	require.NoError(adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:         suite.server.URL + "/offsets",
+		OffsetParam: "offset",
+		LimitParam:  "limit",
+		PageSize:    10,
+		ItemsPath:   "data",
+	}).Slice(0, 20, &posts))
+	require.Len(posts, 20)
+	for i, post := range posts {
+		require.Equal(Post{ID: uint(i + 1), Number: i + 1}, post)
+	}
+
+	// the page numbers no longer match the offsets
+	a := adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:          suite.server.URL + "/capped-pages",
+		PageParam:    "page",
+		PerPageParam: "per_page",
+		PageSize:     10,
+	})
+	require.EqualError(a.Slice(0, 10, &posts), "rest: upstream page of 5 items instead of 10, lower PageSize")
+
+	// a short last page is fine
+	require.NoError(a.Slice(0, 5, &posts))
+	require.Len(posts, 5)
+	require.NoError(suite.pagesAdapter(30).Slice(90, 20, &posts))
+	require.Len(posts, 10)
+}
+
+func (suite *RESTAdapterTestSuite) TestLastShortPage() {
+	var posts []Post
+	require := suite.Require()
+	require.NoError(suite.offsetsAdapter().Slice(95, 10, &posts))
+	require.Len(posts, 5)
+
+	require.NoError(suite.pagesAdapter(30).Slice(120, 10, &posts))
+	require.Empty(posts)
+}
+
+func (suite *RESTAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	_, err := adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:       suite.server.URL + "/pages",
+		PageParam: "page",
+	}).Nums()
+	require.Error(err)
+	require.Contains(err.Error(), "401 Unauthorized")
+
+	_, err = adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:         suite.server.URL + "/offsets",
+		OffsetParam: "offset",
+		TotalPath:   "meta.count",
+	}).Nums()
+	require.Equal(adapter.ErrTotalNotFound, err)
+
+	var posts []Post
+	err = adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:         suite.server.URL + "/offsets",
+		OffsetParam: "offset",
+		ItemsPath:   "meta",
+	}).Slice(0, 10, &posts)
+	require.Error(err)
+	require.Contains(err.Error(), "rest: items")
+}
+
+func (suite *RESTAdapterTestSuite) TestInvalidOptions() {
+	require := suite.Require()
+
+	a := adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:       suite.server.URL + "/offsets",
+		ItemsPath: "data",
+		TotalPath: "meta.total",
+	})
+	_, err := a.Nums()
+	require.EqualError(err, "rest: expected PageParam or OffsetParam but got none")
+
+	var posts []Post
+	require.EqualError(a.Slice(10, 5, &posts), "rest: expected PageParam or OffsetParam but got none")
+	require.Empty(posts)
+
+	a = adapter.NewRESTAdapter(context.Background(), adapter.RESTOptions{
+		URL:         suite.server.URL + "/offsets",
+		PageParam:   "page",
+		OffsetParam: "offset",
+	})
+	_, err = a.Nums()
+	require.EqualError(err, "rest: expected PageParam or OffsetParam but got both")
+	require.EqualError(a.Slice(0, 5, &posts), "rest: expected PageParam or OffsetParam but got both")
+
+	// no request reaches the upstream service
+	require.Empty(suite.requests)
+}
+
+func TestRESTAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(RESTAdapterTestSuite))
+}
@@ -1,6 +1,7 @@
 package adapter
 
 import (
+	"errors"
 	"fmt"
 	"github.com/vcraescu/go-paginator/v2"
 	"reflect"
@@ -8,21 +9,53 @@ import (
 
 // SliceAdapter slice adapter to be passed to paginator constructor to paginate a slice of elements.
 type SliceAdapter struct {
-	src interface{}
+	src      interface{}
+	zeroCopy bool
+	err      error
 }
 
 // NewSliceAdapter slice adapter construct receive the slice source which needs to be paginated.
+// The source can be a slice, an array or a pointer to any of them. A pointer to a slice is read on every call,
+// so the elements appended to the slice after the adapter was created are paginated too.
+// An invalid source makes Nums and Slice return an error.
 func NewSliceAdapter(source interface{}) paginator.Adapter {
-	if isPtr(source) || !isSlice(source) {
-		panic(fmt.Sprintf("expected slice but got %s", reflect.TypeOf(source).Kind()))
+	v := reflect.ValueOf(source)
+	switch {
+	case !v.IsValid():
+		return &SliceAdapter{err: errors.New("expected slice but got nil")}
+	case v.Kind() == reflect.Array:
+		// keep an addressable copy of the array so it can be sliced
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		source = ptr.Interface()
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return &SliceAdapter{err: fmt.Errorf("expected slice but got nil %T", source)}
 	}
 
-	return &SliceAdapter{source}
+	if kind := reflect.Indirect(reflect.ValueOf(source)).Kind(); kind != reflect.Slice && kind != reflect.Array {
+		return &SliceAdapter{err: fmt.Errorf("expected slice but got %T", source)}
+	}
+
+	return &SliceAdapter{src: source}
+}
+
+// NewZeroCopySliceAdapter slice adapter which doesn't copy the elements of the page.
+// The results share the backing array with the source slice whenever the destination has the same type as the source,
+// so changing an element of the results changes the source as well.
+func NewZeroCopySliceAdapter(source interface{}) paginator.Adapter {
+	a := NewSliceAdapter(source).(*SliceAdapter)
+	a.zeroCopy = true
+
+	return a
 }
 
 // Nums returns the number of elements
 func (a *SliceAdapter) Nums() (int64, error) {
-	n := reflect.ValueOf(a.src).Len()
+	if a.err != nil {
+		return 0, a.err
+	}
+
+	n := a.value().Len()
 
 	return int64(n), nil
 }
@@ -30,22 +63,47 @@ func (a *SliceAdapter) Nums() (int64, error) {
 // Slice stores into dest argument a slice of the results.
 // dest argument must be a pointer to a slice
 func (a *SliceAdapter) Slice(offset, length int, dest interface{}) error {
+	if a.err != nil {
+		return a.err
+	}
+
 	// adjust the length for the last page
-	va := reflect.ValueOf(a.src)
+	va := a.value()
 	totalsize := va.Len()
+	if offset > totalsize {
+		offset = totalsize
+	}
+
 	if totalsize < length+offset {
 		length = totalsize - offset
 	}
 
+	vs := va.Slice3(offset, offset+length, offset+length)
+	if a.zeroCopy && isPtr(dest) && reflect.TypeOf(dest).Elem() == vs.Type() {
+		reflect.ValueOf(dest).Elem().Set(vs)
+
+		return nil
+	}
+
 	if err := makeSlice(dest, length, length); err != nil {
 		return err
 	}
 
-	vs := va.Slice(offset, offset+length)
 	vt := reflect.ValueOf(dest).Elem()
+	if vt.Type().Elem() == vs.Type().Elem() {
+		reflect.Copy(vt, vs)
+
+		return nil
+	}
+
 	for i := 0; i < vs.Len(); i++ {
 		vt.Index(i).Set(reflect.ValueOf(vs.Index(i).Interface()))
 	}
 
 	return nil
 }
+
+// value returns the source slice or array
+func (a *SliceAdapter) value() reflect.Value {
+	return reflect.Indirect(reflect.ValueOf(a.src))
+}
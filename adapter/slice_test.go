@@ -111,6 +111,190 @@ func (suite *ArrayAdapterTestSuite) TestCurrentPageResults() {
 	}
 }
 
+func (suite *ArrayAdapterTestSuite) TestInterfaceDestination() {
+	p := paginator.New(adapter.NewSliceAdapter(suite.data), 10)
+
+	require := suite.Require()
+	var items []interface{}
+	p.SetPage(2)
+
+	require.NoError(p.Results(&items))
+	require.Len(items, 10)
+	for i, item := range items {
+		require.Equal(11+i, item)
+	}
+}
+
+func (suite *ArrayAdapterTestSuite) TestOffsetBeyondEnd() {
+	a := adapter.NewSliceAdapter(suite.data)
+
+	require := suite.Require()
+	var items []int
+	require.NoError(a.Slice(120, 10, &items))
+	require.Empty(items)
+}
+
+func (suite *ArrayAdapterTestSuite) TestCopiedResults() {
+	p := paginator.New(adapter.NewSliceAdapter(suite.data), 10)
+
+	require := suite.Require()
+	var items []int
+	require.NoError(p.Results(&items))
+
+	items[0] = -1
+	require.Equal(1, suite.data[0])
+}
+
+func (suite *ArrayAdapterTestSuite) TestZeroCopyResults() {
+	p := paginator.New(adapter.NewZeroCopySliceAdapter(suite.data), 10)
+
+	require := suite.Require()
+	var items []int
+	p.SetPage(10)
+	require.NoError(p.Results(&items))
+	require.Len(items, 10)
+	require.Equal(10, cap(items))
+
+	items[0] = -1
+	require.Equal(-1, suite.data[90])
+
+	// appending must not overwrite the source elements past the page
+	p.SetPage(1)
+	require.NoError(p.Results(&items))
+	_ = append(items, -2)
+	require.Equal(11, suite.data[10])
+}
+
+func (suite *ArrayAdapterTestSuite) TestPointerToSlice() {
+	p := paginator.New(adapter.NewSliceAdapter(&suite.data), 10)
+
+	require := suite.Require()
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(10, pn)
+
+	suite.data = append(suite.data, 101, 102)
+
+	pn, err = p.PageNums()
+	require.NoError(err)
+	require.Equal(11, pn)
+
+	var items []int
+	p.SetPage(11)
+	require.NoError(p.Results(&items))
+	require.Equal([]int{101, 102}, items)
+}
+
+func (suite *ArrayAdapterTestSuite) TestArray() {
+	var data [25]int
+	for i := range data {
+		data[i] = i + 1
+	}
+
+	require := suite.Require()
+	for _, source := range []interface{}{data, &data} {
+		p := paginator.New(adapter.NewSliceAdapter(source), 10)
+
+		n, err := p.Nums()
+		require.NoError(err)
+		require.EqualValues(25, n)
+
+		var items []int
+		p.SetPage(3)
+		require.NoError(p.Results(&items))
+		require.Equal([]int{21, 22, 23, 24, 25}, items)
+	}
+}
+
+func (suite *ArrayAdapterTestSuite) TestInvalidSource() {
+	var (
+		nilSlice *[]int
+		number   = 4
+	)
+
+	tests := []struct {
+		name   string
+		source interface{}
+		err    string
+	}{
+		{
+			name:   "nil",
+			source: nil,
+			err:    "expected slice but got nil",
+		},
+		{
+			name:   "nil pointer",
+			source: nilSlice,
+			err:    "expected slice but got nil *[]int",
+		},
+		{
+			name:   "non slice",
+			source: number,
+			err:    "expected slice but got int",
+		},
+		{
+			name:   "pointer to non slice",
+			source: &number,
+			err:    "expected slice but got *int",
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			require := suite.Require()
+			p := paginator.New(adapter.NewSliceAdapter(test.source), 10)
+
+			_, err := p.Nums()
+			require.EqualError(err, test.err)
+
+			var items []int
+			require.EqualError(p.Results(&items), test.err)
+		})
+	}
+}
+
 func TestArrayAdapterTestSuite(t *testing.T) {
 	suite.Run(t, new(ArrayAdapterTestSuite))
 }
+
+const benchmarkSize = 1000000
+
+func benchmarkData() []int {
+	data := make([]int, benchmarkSize)
+	for i := range data {
+		data[i] = i
+	}
+
+	return data
+}
+
+func benchmarkSliceAdapter(b *testing.B, a paginator.Adapter, newDest func() interface{}) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := a.Slice(0, benchmarkSize, newDest()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSliceAdapterPerElement(b *testing.B) {
+	a := adapter.NewSliceAdapter(benchmarkData())
+	benchmarkSliceAdapter(b, a, func() interface{} {
+		return &[]interface{}{}
+	})
+}
+
+func BenchmarkSliceAdapterCopy(b *testing.B) {
+	a := adapter.NewSliceAdapter(benchmarkData())
+	benchmarkSliceAdapter(b, a, func() interface{} {
+		return &[]int{}
+	})
+}
+
+func BenchmarkSliceAdapterZeroCopy(b *testing.B) {
+	a := adapter.NewZeroCopySliceAdapter(benchmarkData())
+	benchmarkSliceAdapter(b, a, func() interface{} {
+		return &[]int{}
+	})
+}
@@ -0,0 +1,189 @@
+package adapter
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+	"sync"
+	"time"
+)
+
+var (
+	// DefaultSnapshotStore store of the snapshots when SnapshotOptions.Store isn't set
+	DefaultSnapshotStore CacheStore = NewMemoryCacheStore(DefaultCacheMaxEntries)
+
+	// ErrSnapshotNotFound the snapshot of the token doesn't exist or it expired
+	ErrSnapshotNotFound = errors.New("snapshot: not found or expired")
+)
+
+type (
+	// SnapshotOptions snapshot options
+	SnapshotOptions struct {
+		// Namespace identifies the list the snapshots are taken of, e.g. "posts:" followed by the user ID
+		// for a list filtered by permissions. Required, a token is accepted only within its namespace.
+		Namespace string
+		// Token of the snapshot to read, empty to capture a new snapshot
+		Token string
+		// Store the storage of the snapshots, DefaultSnapshotStore by default.
+		// It must be shared by all the requests reading the snapshot.
+		Store CacheStore
+		// TTL the time a new snapshot is kept for, 0 means until evicted by the store
+		TTL time.Duration
+	}
+
+	// SnapshotAdapter adapter to be passed to paginator constructor to paginate a frozen list of items,
+	// so the items inserted or deleted while paging don't shift the pages.
+	// The first request captures the ordered list of IDs and stores it under a token; the next requests
+	// pass the token to read the pages from the same list of IDs. The deleted items are skipped,
+	// so a page can be shorter than requested.
+	SnapshotAdapter struct {
+		capture func() (interface{}, error)
+		page    func(ids interface{}) paginator.Adapter
+		opts    SnapshotOptions
+		err     error
+
+		mu    sync.Mutex
+		token string
+		ids   reflect.Value
+	}
+)
+
+// NewSnapshotAdapter snapshot adapter constructor receive the function capturing the ordered slice of IDs, the function
+// returning the adapter of the items having the given IDs, in the same order, and the snapshot options.
+// The capture function is called only when there is no token.
+func NewSnapshotAdapter(
+	capture func() (interface{}, error),
+	page func(ids interface{}) paginator.Adapter,
+	opts SnapshotOptions,
+) paginator.Adapter {
+	if opts.Namespace == "" {
+		return &SnapshotAdapter{err: errors.New("snapshot: expected Namespace but got none")}
+	}
+
+	if opts.Store == nil {
+		opts.Store = DefaultSnapshotStore
+	}
+
+	return &SnapshotAdapter{capture: capture, page: page, opts: opts}
+}
+
+// Token returns the token of the snapshot, capturing the snapshot if needed.
+// Pass it to the next requests to read the same snapshot.
+func (a *SnapshotAdapter) Token() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.load(); err != nil {
+		return "", err
+	}
+
+	return a.token, nil
+}
+
+// Nums returns the number of IDs of the snapshot
+func (a *SnapshotAdapter) Nums() (int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.load(); err != nil {
+		return 0, err
+	}
+
+	return int64(a.ids.Len()), nil
+}
+
+// Slice stores into dest argument a slice of the items having the IDs of the requested range.
+// dest argument must be a pointer to a slice
+func (a *SnapshotAdapter) Slice(offset, length int, dest interface{}) error {
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	if err := a.load(); err != nil {
+		a.mu.Unlock()
+		return err
+	}
+
+	ids := a.ids
+	a.mu.Unlock()
+
+	if offset > ids.Len() {
+		offset = ids.Len()
+	}
+
+	if ids.Len() < offset+length {
+		length = ids.Len() - offset
+	}
+
+	if length <= 0 {
+		return nil
+	}
+
+	return a.page(ids.Slice(offset, offset+length).Interface()).Slice(0, length, dest)
+}
+
+// load reads the snapshot of the token or captures a new one
+func (a *SnapshotAdapter) load() error {
+	if a.err != nil {
+		return a.err
+	}
+
+	if a.ids.IsValid() {
+		return nil
+	}
+
+	if a.opts.Token != "" {
+		ids, ok := a.opts.Store.Get(a.key(a.opts.Token))
+		if !ok {
+			return ErrSnapshotNotFound
+		}
+
+		v, err := snapshotIDs(ids)
+		if err != nil {
+			return err
+		}
+
+		a.token, a.ids = a.opts.Token, v
+
+		return nil
+	}
+
+	ids, err := a.capture()
+	if err != nil {
+		return err
+	}
+
+	v, err := snapshotIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+
+	a.token, a.ids = hex.EncodeToString(b), v
+	a.opts.Store.Set(a.key(a.token), ids, a.opts.TTL)
+
+	return nil
+}
+
+// snapshotIDs returns the value of the slice of IDs, or an error if ids isn't a slice
+func snapshotIDs(ids interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(ids)
+	if v.Kind() != reflect.Slice {
+		return reflect.Value{}, fmt.Errorf("snapshot: expected slice of IDs but got %T", ids)
+	}
+
+	return v, nil
+}
+
+// key returns the store key of the token within the namespace
+func (a *SnapshotAdapter) key(token string) string {
+	return "snapshot:" + a.opts.Namespace + ":" + token
+}
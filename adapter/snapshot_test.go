@@ -0,0 +1,166 @@
+package adapter_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+type SnapshotAdapterTestSuite struct {
+	suite.Suite
+	db    *gorm.DB
+	store *adapter.MemoryCacheStore
+}
+
+func (suite *SnapshotAdapterTestSuite) SetupTest() {
+	require := suite.Require()
+
+	db, err := gorm.Open(sqlite.Open("file:snapshot?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(err)
+
+	suite.db = db
+	suite.store = adapter.NewMemoryCacheStore(0)
+	require.NoError(suite.db.AutoMigrate(&Post{}))
+
+	for i := 1; i <= 25; i++ {
+		require.NoError(suite.db.Save(&Post{Number: i}).Error)
+	}
+}
+
+func (suite *SnapshotAdapterTestSuite) TearDownTest() {
+	require := suite.Require()
+	rawDB, err := suite.db.DB()
+
+	require.NoError(err)
+	require.NoError(rawDB.Close())
+}
+
+// newAdapter returns the adapter of the posts sorted by number descending, the newest first
+func (suite *SnapshotAdapterTestSuite) newAdapter(token string) paginator.Adapter {
+	return suite.newNamespaceAdapter("posts", token)
+}
+
+// newNamespaceAdapter returns the adapter of newAdapter taking its snapshots in the namespace
+func (suite *SnapshotAdapterTestSuite) newNamespaceAdapter(namespace, token string) paginator.Adapter {
+	return adapter.NewSnapshotAdapter(func() (interface{}, error) {
+		var ids []uint
+		err := suite.db.Model(Post{}).Order("number desc").Pluck("id", &ids).Error
+
+		return ids, err
+	}, func(ids interface{}) paginator.Adapter {
+		var posts []Post
+		if err := suite.db.Where("id IN ?", ids).Order("number desc").Find(&posts).Error; err != nil {
+			return adapter.NewSliceAdapter(nil)
+		}
+
+		return adapter.NewSliceAdapter(posts)
+	}, adapter.SnapshotOptions{Namespace: namespace, Token: token, Store: suite.store, TTL: time.Minute})
+}
+
+func (suite *SnapshotAdapterTestSuite) TestConsistentPages() {
+	require := suite.Require()
+
+	a := suite.newAdapter("")
+	p := paginator.New(a, 10)
+
+	var posts []Post
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	require.Equal(25, posts[0].Number)
+	require.Equal(16, posts[9].Number)
+
+	token, err := a.(*adapter.SnapshotAdapter).Token()
+	require.NoError(err)
+	require.Len(token, 32)
+
+	// a new post shifts the live pages but not the snapshot ones
+	require.NoError(suite.db.Save(&Post{Number: 26}).Error)
+
+	p = paginator.New(suite.newAdapter(token), 10)
+	p.SetPage(2)
+
+	n, err := p.Nums()
+	require.NoError(err)
+	require.EqualValues(25, n)
+
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 10)
+	require.Equal(15, posts[0].Number)
+	require.Equal(6, posts[9].Number)
+
+	// the deleted posts are skipped
+	require.NoError(suite.db.Delete(&Post{}, "number = ?", 3).Error)
+	p.SetPage(3)
+	require.NoError(p.Results(&posts))
+	require.Len(posts, 4)
+	require.Equal(5, posts[0].Number)
+
+	// a new snapshot sees the changes
+	n, err = suite.newAdapter("").Nums()
+	require.NoError(err)
+	require.EqualValues(25, n)
+
+	token2, err := suite.newAdapter("").(*adapter.SnapshotAdapter).Token()
+	require.NoError(err)
+	require.NotEqual(token, token2)
+}
+
+func (suite *SnapshotAdapterTestSuite) TestOffsetBeyondEnd() {
+	var posts []Post
+	suite.Require().NoError(suite.newAdapter("").Slice(30, 10, &posts))
+	suite.Require().Empty(posts)
+}
+
+func (suite *SnapshotAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+
+	_, err := suite.newAdapter("unknown").Nums()
+	require.Equal(adapter.ErrSnapshotNotFound, err)
+
+	failing := adapter.NewSnapshotAdapter(func() (interface{}, error) {
+		return nil, errors.New("db down")
+	}, nil, adapter.SnapshotOptions{Namespace: "posts", Store: suite.store})
+	_, err = failing.(*adapter.SnapshotAdapter).Token()
+	require.EqualError(err, "db down")
+
+	invalid := adapter.NewSnapshotAdapter(func() (interface{}, error) {
+		return 1, nil
+	}, nil, adapter.SnapshotOptions{Namespace: "posts", Store: suite.store})
+	_, err = invalid.Nums()
+	require.EqualError(err, "snapshot: expected slice of IDs but got int")
+
+	// a store returning something else than the slice of IDs, e.g. a serializing store
+	suite.store.Set("snapshot:posts:corrupted", 1, 0)
+	_, err = suite.newAdapter("corrupted").Nums()
+	require.EqualError(err, "snapshot: expected slice of IDs but got int")
+
+	var posts []Post
+	require.EqualError(suite.newAdapter("corrupted").Slice(0, 10, &posts), "snapshot: expected slice of IDs but got int")
+	require.EqualError(suite.newAdapter("").Slice(0, 10, posts), "expected to be a ptr but got []adapter_test.Post")
+
+	_, err = suite.newNamespaceAdapter("", "").Nums()
+	require.EqualError(err, "snapshot: expected Namespace but got none")
+}
+
+func (suite *SnapshotAdapterTestSuite) TestNamespaces() {
+	require := suite.Require()
+
+	token, err := suite.newAdapter("").(*adapter.SnapshotAdapter).Token()
+	require.NoError(err)
+
+	_, err = suite.newNamespaceAdapter("posts:42", token).Nums()
+	require.Equal(adapter.ErrSnapshotNotFound, err)
+
+	nums, err := suite.newAdapter(token).Nums()
+	require.NoError(err)
+	require.NotZero(nums)
+}
+
+func TestSnapshotAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(SnapshotAdapterTestSuite))
+}
@@ -0,0 +1,165 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+	"sync"
+)
+
+var (
+	_ paginator.Peeker = (*StreamAdapter)(nil)
+
+	errInvalidIterator = errors.New("expected iterator func(yield func(T) bool)")
+)
+
+// StreamAdapter adapter for sources without random access, like channels and iterators.
+// The elements are read lazily, only as many as needed to serve the requested page, and kept in memory so the previous
+// pages can be served again. The total number of elements is unknown, so Nums returns paginator.UnknownNums.
+type StreamAdapter struct {
+	mu   sync.Mutex
+	next func() (reflect.Value, bool)
+	stop func()
+	buf  reflect.Value
+	done bool
+	err  error
+}
+
+// NewChanAdapter stream adapter constructor receive the channel to read the elements from.
+// The stream ends when the channel is closed.
+func NewChanAdapter(ch interface{}) paginator.Adapter {
+	v := reflect.ValueOf(ch)
+	if v.Kind() != reflect.Chan || v.Type().ChanDir()&reflect.RecvDir == 0 {
+		return &StreamAdapter{err: fmt.Errorf("expected receive channel but got %T", ch)}
+	}
+
+	return &StreamAdapter{
+		next: v.Recv,
+		buf:  reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), 0, 0),
+	}
+}
+
+// NewIteratorAdapter stream adapter constructor receive an iterator function having the iter.Seq signature:
+// func(yield func(T) bool). The iterator runs in its own goroutine which ends when the iterator is exhausted or
+// when the adapter is closed.
+// The returned adapter implements io.Closer.
+func NewIteratorAdapter(seq interface{}) paginator.Adapter {
+	v := reflect.ValueOf(seq)
+	if v.Kind() != reflect.Func || v.IsNil() || v.Type().NumIn() != 1 || v.Type().NumOut() != 0 {
+		return &StreamAdapter{err: errInvalidIterator}
+	}
+
+	yieldType := v.Type().In(0)
+	if yieldType.Kind() != reflect.Func || yieldType.NumIn() != 1 || yieldType.NumOut() != 1 ||
+		yieldType.Out(0).Kind() != reflect.Bool {
+		return &StreamAdapter{err: errInvalidIterator}
+	}
+
+	elemType := yieldType.In(0)
+	items := make(chan reflect.Value)
+	quit := make(chan struct{})
+	started := false
+	start := func() {
+		started = true
+		go func() {
+			defer close(items)
+
+			yield := reflect.MakeFunc(yieldType, func(args []reflect.Value) []reflect.Value {
+				select {
+				case items <- args[0]:
+					return []reflect.Value{reflect.ValueOf(true)}
+				case <-quit:
+					return []reflect.Value{reflect.ValueOf(false)}
+				}
+			})
+			v.Call([]reflect.Value{yield})
+		}()
+	}
+
+	var once sync.Once
+
+	return &StreamAdapter{
+		next: func() (reflect.Value, bool) {
+			if !started {
+				start()
+			}
+
+			item, ok := <-items
+
+			return item, ok
+		},
+		stop: func() {
+			once.Do(func() {
+				close(quit)
+			})
+		},
+		buf: reflect.MakeSlice(reflect.SliceOf(elemType), 0, 0),
+	}
+}
+
+// Nums returns paginator.UnknownNums
+func (a *StreamAdapter) Nums() (int64, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+
+	return paginator.UnknownNums, nil
+}
+
+// HasMore returns true if the stream has an element at offset
+func (a *StreamAdapter) HasMore(offset int) (bool, error) {
+	if a.err != nil {
+		return false, a.err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.fill(offset + 1)
+
+	return a.buf.Len() > offset, nil
+}
+
+// Slice stores into dest argument a slice of the results.
+// dest argument must be a pointer to a slice
+func (a *StreamAdapter) Slice(offset, length int, dest interface{}) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.fill(offset + length)
+
+	return NewSliceAdapter(a.buf.Interface()).Slice(offset, length, dest)
+}
+
+// Close stops reading from the source.
+// The elements already read are still served.
+func (a *StreamAdapter) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.stop != nil {
+		a.stop()
+	}
+
+	a.done = true
+
+	return nil
+}
+
+// fill reads from the source until there are n buffered elements or the source ends
+func (a *StreamAdapter) fill(n int) {
+	for !a.done && a.buf.Len() < n {
+		item, ok := a.next()
+		if !ok {
+			a.done = true
+			break
+		}
+
+		a.buf = reflect.Append(a.buf, item)
+	}
+}
@@ -0,0 +1,208 @@
+package adapter_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"github.com/vcraescu/go-paginator/v2/view"
+	"io"
+	"sync/atomic"
+	"testing"
+)
+
+type StreamAdapterTestSuite struct {
+	suite.Suite
+	produced int64
+}
+
+func (suite *StreamAdapterTestSuite) SetupTest() {
+	atomic.StoreInt64(&suite.produced, 0)
+}
+
+// seq iterator producing the numbers from 1 to n
+func (suite *StreamAdapterTestSuite) seq(n int) func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		for i := 1; i <= n; i++ {
+			atomic.StoreInt64(&suite.produced, int64(i))
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func (suite *StreamAdapterTestSuite) TestChanFirstPage() {
+	ch := make(chan int, 100)
+	for i := 1; i <= 100; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	p := paginator.New(adapter.NewChanAdapter(ch), 10)
+
+	require := suite.Require()
+	n, err := p.Nums()
+	require.NoError(err)
+	require.Equal(paginator.UnknownNums, n)
+
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(2, pn)
+
+	hn, err := p.HasNext()
+	require.NoError(err)
+	require.True(hn)
+
+	hp, err := p.HasPrev()
+	require.NoError(err)
+	require.False(hp)
+
+	hpages, err := p.HasPages()
+	require.NoError(err)
+	require.True(hpages)
+
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, items)
+	require.Len(ch, 89)
+}
+
+func (suite *StreamAdapterTestSuite) TestChanLastPage() {
+	ch := make(chan int, 25)
+	for i := 1; i <= 25; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	p := paginator.New(adapter.NewChanAdapter(ch), 10)
+	p.SetPage(3)
+
+	require := suite.Require()
+	hn, err := p.HasNext()
+	require.NoError(err)
+	require.False(hn)
+
+	_, err = p.NextPage()
+	require.Equal(paginator.ErrNoNextPage, err)
+
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{21, 22, 23, 24, 25}, items)
+
+	// the previous pages are served from the buffer
+	p.SetPage(1)
+	require.NoError(p.Results(&items))
+	require.Len(items, 10)
+	require.Equal(1, items[0])
+}
+
+func (suite *StreamAdapterTestSuite) TestIteratorReadsLazily() {
+	a := adapter.NewIteratorAdapter(suite.seq(1000))
+	defer a.(io.Closer).Close()
+
+	p := paginator.New(a, 10)
+	p.SetPage(3)
+
+	require := suite.Require()
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{21, 22, 23, 24, 25, 26, 27, 28, 29, 30}, items)
+
+	hn, err := p.HasNext()
+	require.NoError(err)
+	require.True(hn)
+	require.True(atomic.LoadInt64(&suite.produced) <= 32)
+}
+
+func (suite *StreamAdapterTestSuite) TestIteratorEmpty() {
+	a := adapter.NewIteratorAdapter(suite.seq(0))
+	p := paginator.New(a, 10)
+
+	require := suite.Require()
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(1, pn)
+
+	hpages, err := p.HasPages()
+	require.NoError(err)
+	require.False(hpages)
+
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Empty(items)
+}
+
+func (suite *StreamAdapterTestSuite) TestIteratorClose() {
+	a := adapter.NewIteratorAdapter(suite.seq(1000))
+
+	require := suite.Require()
+	var items []int
+	require.NoError(a.Slice(0, 5, &items))
+	require.NoError(a.(io.Closer).Close())
+
+	require.NoError(a.Slice(0, 10, &items))
+	require.Equal([]int{1, 2, 3, 4, 5}, items)
+}
+
+func (suite *StreamAdapterTestSuite) TestView() {
+	a := adapter.NewIteratorAdapter(suite.seq(1000))
+	defer a.(io.Closer).Close()
+
+	p := paginator.New(a, 10)
+	p.SetPage(4)
+	v := view.New(p)
+
+	require := suite.Require()
+	current, err := v.Current()
+	require.NoError(err)
+	require.Equal(4, current)
+
+	next, err := v.Next()
+	require.NoError(err)
+	require.Equal(5, next)
+
+	last, err := v.Last()
+	require.NoError(err)
+	require.Equal(5, last)
+}
+
+func (suite *StreamAdapterTestSuite) TestInvalidSource() {
+	tests := []struct {
+		name   string
+		source paginator.Adapter
+		err    string
+	}{
+		{
+			name:   "non channel",
+			source: adapter.NewChanAdapter([]int{}),
+			err:    "expected receive channel but got []int",
+		},
+		{
+			name:   "send only channel",
+			source: adapter.NewChanAdapter(make(chan<- int)),
+			err:    "expected receive channel but got chan<- int",
+		},
+		{
+			name:   "non iterator",
+			source: adapter.NewIteratorAdapter(func(int) {}),
+			err:    "expected iterator func(yield func(T) bool)",
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			require := suite.Require()
+			p := paginator.New(test.source, 10)
+
+			_, err := p.Nums()
+			require.EqualError(err, test.err)
+
+			var items []int
+			require.EqualError(p.Results(&items), test.err)
+		})
+	}
+}
+
+func TestStreamAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(StreamAdapterTestSuite))
+}
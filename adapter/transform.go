@@ -0,0 +1,91 @@
+package adapter
+
+import (
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+)
+
+var (
+	_ paginator.Peeker = (*TransformAdapter)(nil)
+
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// TransformAdapter adapter wrapper mapping the items of another adapter, e.g. database models to DTOs.
+type TransformAdapter struct {
+	adapter paginator.Adapter
+	fn      reflect.Value
+	err     error
+}
+
+// NewTransformAdapter transform adapter constructor receive the inner adapter and the function mapping its items,
+// having the func(T) U or func(T) (U, error) signature. The inner adapter is read into slices of T and the
+// destination must be a pointer to a slice of U.
+func NewTransformAdapter(adapter paginator.Adapter, fn interface{}) paginator.Adapter {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() || v.Type().IsVariadic() || v.Type().NumIn() != 1 ||
+		v.Type().NumOut() < 1 || v.Type().NumOut() > 2 || (v.Type().NumOut() == 2 && v.Type().Out(1) != errorType) {
+		return &TransformAdapter{err: fmt.Errorf("transform: expected func(T) U or func(T) (U, error) but got %T", fn)}
+	}
+
+	return &TransformAdapter{adapter: adapter, fn: v}
+}
+
+// Nums returns the number of items of the inner adapter
+func (a *TransformAdapter) Nums() (int64, error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+
+	return a.adapter.Nums()
+}
+
+// HasMore returns true if the inner adapter has an item at offset
+func (a *TransformAdapter) HasMore(offset int) (bool, error) {
+	if a.err != nil {
+		return false, a.err
+	}
+
+	peeker, ok := a.adapter.(paginator.Peeker)
+	if !ok {
+		return false, paginator.ErrUnknownNums
+	}
+
+	return peeker.HasMore(offset)
+}
+
+// Slice stores into dest argument a slice of the mapped items.
+// dest argument must be a pointer to a slice
+func (a *TransformAdapter) Slice(offset, length int, dest interface{}) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	if err := makeSlice(dest, 0, 0); err != nil {
+		return err
+	}
+
+	vt := reflect.ValueOf(dest).Elem()
+	if out := a.fn.Type().Out(0); !out.AssignableTo(vt.Type().Elem()) {
+		return fmt.Errorf("transform: expected *[]%s destination but got %T", out, dest)
+	}
+
+	buf := reflect.New(reflect.SliceOf(a.fn.Type().In(0)))
+	if err := a.adapter.Slice(offset, length, buf.Interface()); err != nil {
+		return err
+	}
+
+	items := buf.Elem()
+	vt.Set(reflect.MakeSlice(vt.Type(), items.Len(), items.Len()))
+	for i := 0; i < items.Len(); i++ {
+		out := a.fn.Call([]reflect.Value{items.Index(i)})
+		if len(out) == 2 && !out[1].IsNil() {
+			return fmt.Errorf("transform: item %d: %w", offset+i, out[1].Interface().(error))
+		}
+
+		vt.Index(i).Set(out[0])
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package adapter_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type (
+	PostDTO struct {
+		ID    string
+		Title string
+	}
+
+	TransformAdapterTestSuite struct {
+		suite.Suite
+		db *gorm.DB
+	}
+)
+
+func toPostDTO(p Post) PostDTO {
+	return PostDTO{ID: fmt.Sprint(p.ID), Title: fmt.Sprintf("Post #%d", p.Number)}
+}
+
+func (suite *TransformAdapterTestSuite) SetupTest() {
+	require := suite.Require()
+
+	db, err := gorm.Open(sqlite.Open("file:transform?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(err)
+
+	suite.db = db
+	require.NoError(suite.db.AutoMigrate(&Post{}))
+
+	for i := 1; i <= 25; i++ {
+		require.NoError(suite.db.Save(&Post{Number: i}).Error)
+	}
+}
+
+func (suite *TransformAdapterTestSuite) TearDownTest() {
+	require := suite.Require()
+	rawDB, err := suite.db.DB()
+
+	require.NoError(err)
+	require.NoError(rawDB.Close())
+}
+
+func (suite *TransformAdapterTestSuite) TestGORM() {
+	p := paginator.New(adapter.NewTransformAdapter(adapter.NewGORMAdapter(suite.db.Model(Post{})), toPostDTO), 10)
+
+	require := suite.Require()
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(3, pn)
+
+	var dtos []PostDTO
+	require.NoError(p.Results(&dtos))
+	require.Len(dtos, 10)
+	require.Equal(PostDTO{ID: "1", Title: "Post #1"}, dtos[0])
+
+	p.SetPage(3)
+	require.NoError(p.Results(&dtos))
+	require.Len(dtos, 5)
+	require.Equal(PostDTO{ID: "25", Title: "Post #25"}, dtos[4])
+}
+
+func (suite *TransformAdapterTestSuite) TestFuncWithError() {
+	a := adapter.NewTransformAdapter(adapter.NewSliceAdapter([]int{1, 2, 3, 4}), func(n int) (string, error) {
+		if n > 3 {
+			return "", errors.New("too big")
+		}
+
+		return fmt.Sprint(n * n), nil
+	})
+
+	require := suite.Require()
+	var items []string
+	require.NoError(a.Slice(0, 3, &items))
+	require.Equal([]string{"1", "4", "9"}, items)
+
+	require.EqualError(a.Slice(2, 2, &items), "transform: item 3: too big")
+}
+
+func (suite *TransformAdapterTestSuite) TestUnknownNums() {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	p := paginator.New(adapter.NewTransformAdapter(adapter.NewChanAdapter(ch), func(n int) string {
+		return fmt.Sprint(n)
+	}), 2)
+
+	require := suite.Require()
+	var items []string
+	require.NoError(p.Results(&items))
+	require.Equal([]string{"1", "2"}, items)
+
+	hasNext, err := p.HasNext()
+	require.NoError(err)
+	require.True(hasNext)
+}
+
+func (suite *TransformAdapterTestSuite) TestErrors() {
+	require := suite.Require()
+	inner := adapter.NewSliceAdapter([]int{1, 2, 3})
+
+	var items []string
+	err := adapter.NewTransformAdapter(inner, func(n int) (string, bool) { return "", false }).Slice(0, 10, &items)
+	require.EqualError(err, "transform: expected func(T) U or func(T) (U, error) but got func(int) (string, bool)")
+
+	var numbers []int
+	err = adapter.NewTransformAdapter(inner, fmt.Sprint).Slice(0, 10, &numbers)
+	require.EqualError(err, "transform: expected func(T) U or func(T) (U, error) but got func(...interface {}) string")
+
+	err = adapter.NewTransformAdapter(inner, func(n int) string { return "" }).Slice(0, 10, &numbers)
+	require.EqualError(err, "transform: expected *[]string destination but got *[]int")
+
+	err = adapter.NewTransformAdapter(adapter.NewSliceAdapter(nil), func(n int) string { return "" }).Slice(0, 10, &items)
+	require.EqualError(err, "expected slice but got nil")
+}
+
+func TestTransformAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(TransformAdapterTestSuite))
+}
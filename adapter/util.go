@@ -14,7 +14,7 @@ func isSlice(data interface{}) bool {
 func isPtr(data interface{}) bool {
 	t := reflect.TypeOf(data)
 
-	return t.Kind() == reflect.Ptr
+	return t != nil && t.Kind() == reflect.Ptr
 }
 
 func makeSlice(data interface{}, length, cap int) error {
@@ -62,7 +62,7 @@ func TestIsPtr(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			require.Equal(t, test.expected, isSlice(test.value))
+			require.Equal(t, test.expected, isPtr(test.value))
 		})
 	}
 }
@@ -84,6 +84,11 @@ func TestMakeSlice(t *testing.T) {
 			value: 4,
 			err:   true,
 		},
+		{
+			name:  "nil",
+			value: nil,
+			err:   true,
+		},
 	}
 
 	for _, test := range tests {
@@ -1,10 +1,12 @@
 module github.com/vcraescu/go-paginator/v2
 
-go 1.15
+go 1.16
 
 require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/stretchr/testify v1.3.0
+	github.com/alicebob/miniredis/v2 v2.14.3
+	github.com/go-redis/redis/v8 v8.11.5
+	github.com/stretchr/testify v1.5.1
+	go.etcd.io/bbolt v1.3.6
 	gorm.io/driver/sqlite v1.1.3
 	gorm.io/gorm v1.20.6
 )
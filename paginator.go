@@ -5,8 +5,16 @@ import (
 	"math"
 )
 
-// DefaultMaxPerPage default number of records per page
-const DefaultMaxPerPage = 10
+const (
+	// DefaultMaxPerPage default number of records per page
+	DefaultMaxPerPage = 10
+
+	// UnknownNums returned by the adapters which can't count their records upfront.
+	// The paginator then works without a total number of records: the adapter, which must implement Peeker,
+	// is asked whether there are records after the current page and a page past the end is stepped back
+	// to the last page having records.
+	UnknownNums int64 = -1
+)
 
 var (
 	// ErrNoPrevPage current page is first page
@@ -14,6 +22,9 @@ var (
 
 	// ErrNoNextPage current page is last page
 	ErrNoNextPage = errors.New("no next page")
+
+	// ErrUnknownNums the adapter doesn't know the number of records and doesn't implement Peeker
+	ErrUnknownNums = errors.New("unknown number of records")
 )
 
 type (
@@ -23,6 +34,12 @@ type (
 		Slice(offset, length int, data interface{}) error
 	}
 
+	// Peeker must be implemented by the adapters returning UnknownNums
+	Peeker interface {
+		// HasMore returns true if there is a record at offset
+		HasMore(offset int) (bool, error)
+	}
+
 	// Paginator interface
 	Paginator interface {
 		SetPage(page int)
@@ -42,7 +59,10 @@ type (
 		adapter    Adapter
 		maxPerPage int
 		page       int
-		nums       int64
+		// unknownNums true once the adapter reported UnknownNums, it's not asked again then
+		unknownNums bool
+		// checkedPage the current page of a count-free adapter once checked, 0 until then
+		checkedPage int
 	}
 )
 
@@ -56,7 +76,6 @@ func New(adapter Adapter, maxPerPage int) Paginator {
 		adapter:    adapter,
 		maxPerPage: maxPerPage,
 		page:       1,
-		nums:       -1,
 	}
 }
 
@@ -67,15 +86,21 @@ func (p *paginator) SetPage(page int) {
 	}
 
 	p.page = page
+	p.checkedPage = 0
 }
 
 // Page returns current page
-func (p paginator) Page() (int, error) {
-	pn, err := p.PageNums()
+func (p *paginator) Page() (int, error) {
+	n, err := p.Nums()
 	if err != nil {
 		return 0, err
 	}
 
+	if n == UnknownNums {
+		return p.unknownPage()
+	}
+
+	pn := p.countedPageNums(n)
 	if p.page > pn {
 		return pn, nil
 	}
@@ -84,7 +109,7 @@ func (p paginator) Page() (int, error) {
 }
 
 // Results stores the current page results into data argument which must be a pointer to a slice.
-func (p paginator) Results(data interface{}) error {
+func (p *paginator) Results(data interface{}) error {
 	var offset int
 	page, err := p.Page()
 	if err != nil {
@@ -98,31 +123,44 @@ func (p paginator) Results(data interface{}) error {
 	return p.adapter.Slice(offset, p.maxPerPage, data)
 }
 
-// Nums returns the total number of records
+// Nums returns the total number of records or UnknownNums if the adapter can't count them.
+// An adapter which can't count its records isn't asked again.
 func (p *paginator) Nums() (int64, error) {
-	var err error
-	if p.nums == -1 {
-		p.nums, err = p.adapter.Nums()
-		if err != nil {
-			return 0, err
-		}
+	if p.unknownNums {
+		return UnknownNums, nil
+	}
+
+	n, err := p.adapter.Nums()
+	if err != nil {
+		return 0, err
 	}
 
-	return p.nums, nil
+	p.unknownNums = n == UnknownNums
+
+	return n, nil
 }
 
 // HasPages returns true if there is more than one page
-func (p paginator) HasPages() (bool, error) {
+func (p *paginator) HasPages() (bool, error) {
 	n, err := p.Nums()
 	if err != nil {
 		return false, err
 	}
 
+	if n == UnknownNums {
+		pn, err := p.PageNums()
+		if err != nil {
+			return false, err
+		}
+
+		return pn > 1, nil
+	}
+
 	return n > int64(p.maxPerPage), nil
 }
 
 // HasNext returns true if current page is not the last page
-func (p paginator) HasNext() (bool, error) {
+func (p *paginator) HasNext() (bool, error) {
 	pn, err := p.PageNums()
 	if err != nil {
 		return false, err
@@ -137,7 +175,7 @@ func (p paginator) HasNext() (bool, error) {
 }
 
 // PrevPage returns previous page number or ErrNoPrevPage if current page is first page
-func (p paginator) PrevPage() (int, error) {
+func (p *paginator) PrevPage() (int, error) {
 	hp, err := p.HasPrev()
 	if err != nil {
 		return 0, nil
@@ -156,7 +194,7 @@ func (p paginator) PrevPage() (int, error) {
 }
 
 // NextPage returns next page number or ErrNoNextPage if current page is last page
-func (p paginator) NextPage() (int, error) {
+func (p *paginator) NextPage() (int, error) {
 	hn, err := p.HasNext()
 	if err != nil {
 		return 0, err
@@ -175,7 +213,7 @@ func (p paginator) NextPage() (int, error) {
 }
 
 // HasPrev returns true if current page is not the first page
-func (p paginator) HasPrev() (bool, error) {
+func (p *paginator) HasPrev() (bool, error) {
 	page, err := p.Page()
 	if err != nil {
 		return false, err
@@ -184,17 +222,97 @@ func (p paginator) HasPrev() (bool, error) {
 	return page > 1, nil
 }
 
-// PageNums returns the total number of pages
-func (p paginator) PageNums() (int, error) {
+// PageNums returns the total number of pages.
+// If the adapter can't count the records, it returns the number of pages known so far:
+// the current page plus one if there are records after it.
+func (p *paginator) PageNums() (int, error) {
 	n, err := p.Nums()
 	if err != nil {
 		return 0, err
 	}
 
+	if n == UnknownNums {
+		page, err := p.unknownPage()
+		if err != nil {
+			return 0, err
+		}
+
+		more, err := p.adapter.(Peeker).HasMore(page * p.maxPerPage)
+		if err != nil {
+			return 0, err
+		}
+
+		if more {
+			return page + 1, nil
+		}
+
+		return page, nil
+	}
+
+	return p.countedPageNums(n), nil
+}
+
+// countedPageNums returns the number of pages of n records, at least one
+func (p *paginator) countedPageNums(n int64) int {
 	n = int64(math.Ceil(float64(n) / float64(p.maxPerPage)))
 	if n == 0 {
 		n = 1
 	}
 
-	return int(n), nil
+	return int(n)
+}
+
+// unknownPage returns the current page of an adapter which can't count its records.
+// A page past the end is stepped back to the last page having records, the first page is kept even if it's empty.
+// The adapter is asked only once, the result is kept until the page changes.
+func (p *paginator) unknownPage() (int, error) {
+	peeker, ok := p.adapter.(Peeker)
+	if !ok {
+		return 0, ErrUnknownNums
+	}
+
+	if p.checkedPage == 0 {
+		page, err := p.searchPage(peeker)
+		if err != nil {
+			return 0, err
+		}
+
+		p.checkedPage = page
+	}
+
+	return p.checkedPage, nil
+}
+
+// searchPage returns the current page if it has records or else the last page having records
+func (p *paginator) searchPage(peeker Peeker) (int, error) {
+	if p.page == 1 {
+		return 1, nil
+	}
+
+	more, err := peeker.HasMore((p.page - 1) * p.maxPerPage)
+	if err != nil {
+		return 0, err
+	}
+
+	if more {
+		return p.page, nil
+	}
+
+	// the pages having records come first, search the last one of them
+	first, last := 1, p.page-1
+	for first < last {
+		mid := first + (last-first+1)/2
+		more, err := peeker.HasMore((mid - 1) * p.maxPerPage)
+		if err != nil {
+			return 0, err
+		}
+
+		if more {
+			first = mid
+		} else {
+			last = mid - 1
+		}
+	}
+
+	return first, nil
 }
@@ -8,6 +8,7 @@ import (
 
 var (
 	_ paginator.Adapter = (*GenericAdapter)(nil)
+	_ paginator.Peeker  = (*UncountedAdapter)(nil)
 )
 
 type (
@@ -19,6 +20,19 @@ type (
 	GenericAdapter struct {
 		nums int64
 	}
+
+	UncountedAdapter struct {
+		GenericAdapter
+	}
+
+	NonPeekingAdapter struct {
+		GenericAdapter
+	}
+
+	CountingAdapter struct {
+		paginator.Adapter
+		calls int
+	}
 )
 
 func (p GenericAdapter) Nums() (int64, error) {
@@ -35,6 +49,28 @@ func (p GenericAdapter) Slice(offset, length int, data interface{}) error {
 	return nil
 }
 
+func (p UncountedAdapter) Nums() (int64, error) {
+	return paginator.UnknownNums, nil
+}
+
+func (p UncountedAdapter) HasMore(offset int) (bool, error) {
+	return int64(offset) < p.nums, nil
+}
+
+func (p NonPeekingAdapter) Nums() (int64, error) {
+	return paginator.UnknownNums, nil
+}
+
+func (p *CountingAdapter) Nums() (int64, error) {
+	p.calls++
+
+	return p.Adapter.Nums()
+}
+
+func (p *CountingAdapter) HasMore(offset int) (bool, error) {
+	return p.Adapter.(paginator.Peeker).HasMore(offset)
+}
+
 type PaginatorTestSuite struct {
 	suite.Suite
 }
@@ -116,6 +152,121 @@ func (suite *PaginatorTestSuite) TestCurrentPageResults() {
 	}
 }
 
+func (suite *PaginatorTestSuite) TestUnknownNums() {
+	p := paginator.New(&UncountedAdapter{GenericAdapter{nums: 25}}, 10)
+
+	n, err := p.Nums()
+	suite.NoError(err)
+	suite.Equal(paginator.UnknownNums, n)
+
+	pn, err := p.PageNums()
+	suite.NoError(err)
+	suite.Equal(2, pn)
+
+	hpages, err := p.HasPages()
+	suite.NoError(err)
+	suite.True(hpages)
+
+	p.SetPage(3)
+
+	pn, err = p.PageNums()
+	suite.NoError(err)
+	suite.Equal(3, pn)
+
+	hn, err := p.HasNext()
+	suite.NoError(err)
+	suite.False(hn)
+
+	prev, err := p.PrevPage()
+	suite.NoError(err)
+	suite.Equal(2, prev)
+
+	// a page past the end is stepped back to the last page
+	p.SetPage(5)
+
+	page, err := p.Page()
+	suite.NoError(err)
+	suite.Equal(3, page)
+}
+
+func (suite *PaginatorTestSuite) TestUnknownNumsPagePastEnd() {
+	for _, nums := range []int64{25, 30, 0} {
+		p := paginator.New(&UncountedAdapter{GenericAdapter{nums: nums}}, 10)
+		p.SetPage(100)
+
+		last := int((nums + 9) / 10)
+		if last == 0 {
+			last = 1
+		}
+
+		page, err := p.Page()
+		suite.NoError(err)
+		suite.Equal(last, page, "nums %d", nums)
+
+		pn, err := p.PageNums()
+		suite.NoError(err)
+		suite.Equal(last, pn, "nums %d", nums)
+
+		hn, err := p.HasNext()
+		suite.NoError(err)
+		suite.False(hn)
+
+		hp, err := p.HasPrev()
+		suite.NoError(err)
+		suite.Equal(last > 1, hp, "nums %d", nums)
+
+		var posts []Post
+		suite.NoError(p.Results(&posts))
+		if last > 1 {
+			suite.Equal((last-1)*10+1, posts[0].Number)
+		}
+	}
+}
+
+func (suite *PaginatorTestSuite) TestUnknownNumsWithoutPeeker() {
+	p := paginator.New(&NonPeekingAdapter{}, 10)
+
+	_, err := p.PageNums()
+	suite.Equal(paginator.ErrUnknownNums, err)
+
+	_, err = p.HasNext()
+	suite.Equal(paginator.ErrUnknownNums, err)
+}
+
+func (suite *PaginatorTestSuite) TestUnknownNumsFetchedOnce() {
+	a := &CountingAdapter{Adapter: &UncountedAdapter{GenericAdapter{nums: 25}}}
+	p := paginator.New(a, 10)
+	p.SetPage(2)
+
+	_, err := p.PageNums()
+	suite.NoError(err)
+
+	_, err = p.HasNext()
+	suite.NoError(err)
+
+	_, err = p.PrevPage()
+	suite.NoError(err)
+
+	var posts []Post
+	suite.NoError(p.Results(&posts))
+
+	_, err = p.Nums()
+	suite.NoError(err)
+	suite.Equal(1, a.calls)
+}
+
+func (suite *PaginatorTestSuite) TestNumsFetchedAgain() {
+	a := &CountingAdapter{Adapter: &GenericAdapter{nums: 25}}
+	p := paginator.New(a, 10)
+
+	_, err := p.Nums()
+	suite.NoError(err)
+
+	_, err = p.PageNums()
+	suite.NoError(err)
+	suite.Equal(2, a.calls)
+}
+
 func TestPluginTestSuite(t *testing.T) {
 	suite.Run(t, new(PaginatorTestSuite))
 }
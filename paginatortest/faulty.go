@@ -0,0 +1,96 @@
+package paginatortest
+
+import (
+	"errors"
+	"github.com/vcraescu/go-paginator/v2"
+	"sync"
+	"time"
+)
+
+var (
+	_ paginator.Peeker = (*FaultyAdapter)(nil)
+
+	// ErrInjected a sample error to be injected
+	ErrInjected = errors.New("paginatortest: injected error")
+)
+
+type (
+	// Faults the faults injected by FaultyAdapter
+	Faults struct {
+		// NumsErr returned by Nums
+		NumsErr error
+		// SliceErr returned by Slice and HasMore
+		SliceErr error
+		// FailAfter the number of calls succeeding before the errors are returned
+		FailAfter int
+		// Latency added to every call
+		Latency time.Duration
+		// NumsDelta added to the number of items, so the count is inconsistent with the slices,
+		// like when items are deleted between counting and slicing them
+		NumsDelta int64
+	}
+
+	// FaultyAdapter adapter wrapper injecting faults into another adapter, to test how the code using a paginator
+	// behaves when the data source misbehaves.
+	FaultyAdapter struct {
+		adapter paginator.Adapter
+		faults  Faults
+
+		mu    sync.Mutex
+		calls int
+	}
+)
+
+// NewFaultyAdapter faulty adapter constructor receive the inner adapter and the faults to be injected.
+func NewFaultyAdapter(adapter paginator.Adapter, faults Faults) *FaultyAdapter {
+	return &FaultyAdapter{adapter: adapter, faults: faults}
+}
+
+// Nums returns the number of items of the inner adapter plus NumsDelta, or NumsErr
+func (a *FaultyAdapter) Nums() (int64, error) {
+	if a.fail() && a.faults.NumsErr != nil {
+		return 0, a.faults.NumsErr
+	}
+
+	n, err := a.adapter.Nums()
+	if err != nil || n == paginator.UnknownNums {
+		return n, err
+	}
+
+	return n + a.faults.NumsDelta, nil
+}
+
+// HasMore returns the result of the inner adapter's HasMore, or SliceErr
+func (a *FaultyAdapter) HasMore(offset int) (bool, error) {
+	if a.fail() && a.faults.SliceErr != nil {
+		return false, a.faults.SliceErr
+	}
+
+	peeker, ok := a.adapter.(paginator.Peeker)
+	if !ok {
+		return false, paginator.ErrUnknownNums
+	}
+
+	return peeker.HasMore(offset)
+}
+
+// Slice stores into dest argument the slice of the inner adapter, or returns SliceErr
+func (a *FaultyAdapter) Slice(offset, length int, dest interface{}) error {
+	if a.fail() && a.faults.SliceErr != nil {
+		return a.faults.SliceErr
+	}
+
+	return a.adapter.Slice(offset, length, dest)
+}
+
+// fail waits for Latency and returns true if the call must fail
+func (a *FaultyAdapter) fail() bool {
+	time.Sleep(a.faults.Latency)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.calls++
+
+	return a.calls > a.faults.FailAfter
+}
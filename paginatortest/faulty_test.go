@@ -0,0 +1,107 @@
+package paginatortest_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/paginatortest"
+	"testing"
+	"time"
+)
+
+type FaultyAdapterTestSuite struct {
+	suite.Suite
+	items []int
+}
+
+func (suite *FaultyAdapterTestSuite) SetupTest() {
+	suite.items = make([]int, 25)
+	for i := range suite.items {
+		suite.items[i] = i + 1
+	}
+}
+
+func (suite *FaultyAdapterTestSuite) TestErrors() {
+	a := paginatortest.NewFaultyAdapter(paginatortest.NewMockAdapter(suite.items), paginatortest.Faults{
+		SliceErr:  paginatortest.ErrInjected,
+		FailAfter: 1,
+	})
+
+	require := suite.Require()
+	var items []int
+	require.NoError(a.Slice(0, 10, &items))
+	require.Len(items, 10)
+	require.Equal(paginatortest.ErrInjected, a.Slice(10, 10, &items))
+
+	// Nums isn't failing
+	n, err := a.Nums()
+	require.NoError(err)
+	require.EqualValues(25, n)
+
+	p := paginator.New(paginatortest.NewFaultyAdapter(paginatortest.NewMockAdapter(suite.items), paginatortest.Faults{
+		NumsErr: paginatortest.ErrInjected,
+	}), 10)
+	_, err = p.PageNums()
+	require.Equal(paginatortest.ErrInjected, err)
+	require.Equal(paginatortest.ErrInjected, p.Results(&items))
+}
+
+func (suite *FaultyAdapterTestSuite) TestConformanceFailing() {
+	suite.Require().NoError(paginatortest.TestAdapter(paginatortest.Config{
+		New: func(n int) (paginator.Adapter, error) {
+			return paginatortest.NewFaultyAdapter(paginatortest.NewMockAdapter(suite.items[:n]), paginatortest.Faults{}), nil
+		},
+		Items: suite.items,
+		NewFailing: func() (paginator.Adapter, error) {
+			return paginatortest.NewFaultyAdapter(paginatortest.NewMockAdapter(suite.items), paginatortest.Faults{
+				SliceErr: paginatortest.ErrInjected,
+			}), nil
+		},
+	}))
+}
+
+func (suite *FaultyAdapterTestSuite) TestInconsistentNums() {
+	a := paginatortest.NewFaultyAdapter(paginatortest.NewMockAdapter(suite.items), paginatortest.Faults{NumsDelta: 10})
+	p := paginator.New(a, 10)
+	p.SetPage(4)
+
+	require := suite.Require()
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(4, pn)
+
+	// the last page is empty since the items were "deleted" after counting them
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Empty(items)
+}
+
+func (suite *FaultyAdapterTestSuite) TestLatency() {
+	a := paginatortest.NewFaultyAdapter(paginatortest.NewMockAdapter(suite.items), paginatortest.Faults{
+		Latency: 20 * time.Millisecond,
+	})
+
+	require := suite.Require()
+	start := time.Now()
+	_, err := a.Nums()
+	require.NoError(err)
+	require.True(time.Since(start) >= 20*time.Millisecond)
+}
+
+func (suite *FaultyAdapterTestSuite) TestHasMore() {
+	require := suite.Require()
+
+	a := paginatortest.NewFaultyAdapter(paginatortest.NewMockAdapter(suite.items), paginatortest.Faults{})
+	more, err := a.HasMore(24)
+	require.NoError(err)
+	require.True(more)
+
+	a = paginatortest.NewFaultyAdapter(paginatortest.NewMockAdapter(suite.items), paginatortest.Faults{
+		SliceErr: paginatortest.ErrInjected,
+	})
+	_, err = a.HasMore(0)
+	require.Equal(paginatortest.ErrInjected, err)
+}
+
+func TestFaultyAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(FaultyAdapterTestSuite))
+}
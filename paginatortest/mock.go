@@ -0,0 +1,109 @@
+package paginatortest
+
+import (
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"sync"
+)
+
+var _ paginator.Peeker = (*MockAdapter)(nil)
+
+type (
+	// Call a call of an adapter method
+	Call struct {
+		// Method the method name: "Nums", "HasMore" or "Slice"
+		Method string
+		// Offset the offset argument of HasMore and Slice
+		Offset int
+		// Length the length argument of Slice
+		Length int
+		// Dest the dest argument of Slice
+		Dest interface{}
+	}
+
+	// MockAdapter adapter recording all its calls. By default it serves the items of a slice;
+	// set NumsFunc, HasMoreFunc or SliceFunc to change its behaviour.
+	MockAdapter struct {
+		NumsFunc    func() (int64, error)
+		HasMoreFunc func(offset int) (bool, error)
+		SliceFunc   func(offset, length int, dest interface{}) error
+
+		mu    sync.Mutex
+		calls []Call
+	}
+)
+
+// NewMockAdapter mock adapter constructor receive the slice of items it serves, it can be nil.
+func NewMockAdapter(items interface{}) *MockAdapter {
+	if items == nil {
+		items = []interface{}{}
+	}
+
+	a := adapter.NewSliceAdapter(items)
+
+	return &MockAdapter{
+		NumsFunc: a.Nums,
+		HasMoreFunc: func(offset int) (bool, error) {
+			n, err := a.Nums()
+
+			return int64(offset) < n, err
+		},
+		SliceFunc: a.Slice,
+	}
+}
+
+// Nums records the call and returns the result of NumsFunc
+func (a *MockAdapter) Nums() (int64, error) {
+	a.record(Call{Method: "Nums"})
+
+	return a.NumsFunc()
+}
+
+// HasMore records the call and returns the result of HasMoreFunc
+func (a *MockAdapter) HasMore(offset int) (bool, error) {
+	a.record(Call{Method: "HasMore", Offset: offset})
+
+	return a.HasMoreFunc(offset)
+}
+
+// Slice records the call and returns the result of SliceFunc
+func (a *MockAdapter) Slice(offset, length int, dest interface{}) error {
+	a.record(Call{Method: "Slice", Offset: offset, Length: length, Dest: dest})
+
+	return a.SliceFunc(offset, length, dest)
+}
+
+// Calls returns the calls recorded so far, in order
+func (a *MockAdapter) Calls() []Call {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return append([]Call(nil), a.calls...)
+}
+
+// CallsOf returns the calls of the method recorded so far, in order
+func (a *MockAdapter) CallsOf(method string) []Call {
+	var calls []Call
+	for _, call := range a.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the calls recorded so far
+func (a *MockAdapter) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.calls = nil
+}
+
+func (a *MockAdapter) record(call Call) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.calls = append(a.calls, call)
+}
@@ -0,0 +1,80 @@
+package paginatortest_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/paginatortest"
+	"testing"
+)
+
+type MockAdapterTestSuite struct {
+	suite.Suite
+	items []int
+}
+
+func (suite *MockAdapterTestSuite) SetupTest() {
+	suite.items = make([]int, 25)
+	for i := range suite.items {
+		suite.items[i] = i + 1
+	}
+}
+
+func (suite *MockAdapterTestSuite) TestConformance() {
+	suite.Require().NoError(paginatortest.TestAdapter(paginatortest.Config{
+		New: func(n int) (paginator.Adapter, error) {
+			return paginatortest.NewMockAdapter(suite.items[:n]), nil
+		},
+		Items: suite.items,
+	}))
+}
+
+func (suite *MockAdapterTestSuite) TestCalls() {
+	a := paginatortest.NewMockAdapter(suite.items)
+	p := paginator.New(a, 10)
+	p.SetPage(3)
+
+	require := suite.Require()
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Equal([]int{21, 22, 23, 24, 25}, items)
+
+	calls := a.Calls()
+	require.Equal("Nums", calls[0].Method)
+
+	slices := a.CallsOf("Slice")
+	require.Len(slices, 1)
+	require.Equal(paginatortest.Call{Method: "Slice", Offset: 20, Length: 10, Dest: &items}, slices[0])
+
+	a.Reset()
+	require.Empty(a.Calls())
+}
+
+func (suite *MockAdapterTestSuite) TestFuncs() {
+	a := paginatortest.NewMockAdapter(nil)
+	a.NumsFunc = func() (int64, error) {
+		return paginator.UnknownNums, nil
+	}
+	a.HasMoreFunc = func(offset int) (bool, error) {
+		return offset < 15, nil
+	}
+
+	p := paginator.New(a, 10)
+	p.SetPage(2)
+
+	require := suite.Require()
+	hasNext, err := p.HasNext()
+	require.NoError(err)
+	require.False(hasNext)
+	require.Equal([]paginatortest.Call{
+		{Method: "HasMore", Offset: 10},
+		{Method: "HasMore", Offset: 20},
+	}, a.CallsOf("HasMore"))
+
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Empty(items)
+}
+
+func TestMockAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(MockAdapterTestSuite))
+}
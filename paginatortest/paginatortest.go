@@ -0,0 +1,252 @@
+// Package paginatortest implements support for testing implementations of paginator.Adapter.
+package paginatortest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"reflect"
+	"strings"
+)
+
+// Config the adapters to be tested
+type Config struct {
+	// New returns an adapter over the first n items of Items.
+	// It's called with n = len(Items) and with n = 0 to test the empty sources.
+	New func(n int) (paginator.Adapter, error)
+	// Items the items, in the order the adapter returns them, as a slice of the type filled by the adapter.
+	// It must hold at least 1 item, the more the better, e.g. 25.
+	Items interface{}
+	// NewFailing returns an adapter whose data source fails, e.g. over a closed database connection.
+	// Optional, when it's set the errors of the data source must be returned by Slice.
+	NewFailing func() (paginator.Adapter, error)
+}
+
+// panicError a panic of the adapter
+type panicError struct {
+	value interface{}
+}
+
+func (e panicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.value)
+}
+
+// pageSizes the page sizes the slices are tested with, besides the number of items and the number of items plus one
+var pageSizes = []int{1, 2, 3, 7, 10}
+
+// TestAdapter tests the adapters of cfg:
+// the number of items, the slices of every page for several page sizes including the short last page,
+// the empty sources, the offsets beyond the end, the error propagation and the destination type handling.
+// It returns an error describing all the failures, or nil.
+//
+// Typical usage inside a test is:
+//
+//	if err := paginatortest.TestAdapter(cfg); err != nil {
+//		t.Fatal(err)
+//	}
+func TestAdapter(cfg Config) error {
+	items := reflect.ValueOf(cfg.Items)
+	if items.Kind() != reflect.Slice || items.Len() == 0 {
+		return fmt.Errorf("paginatortest: expected non-empty slice of items but got %T", cfg.Items)
+	}
+
+	t := &tester{items: items}
+
+	a, err := cfg.New(items.Len())
+	if err != nil {
+		return fmt.Errorf("paginatortest: New(%d): %w", items.Len(), err)
+	}
+
+	t.testNums(a, items.Len())
+	t.testSlices(a)
+	t.testOffsetBeyondEnd(a)
+	t.testDestinations(a)
+
+	if a, err = cfg.New(0); err != nil {
+		t.errorf("New(0): %v", err)
+	} else {
+		t.testEmpty(a)
+	}
+
+	if cfg.NewFailing != nil {
+		if a, err = cfg.NewFailing(); err != nil {
+			t.errorf("NewFailing(): %v", err)
+		} else {
+			t.testFailing(a)
+		}
+	}
+
+	return t.err()
+}
+
+type tester struct {
+	items  reflect.Value
+	errors []string
+}
+
+func (t *tester) errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *tester) err() error {
+	if len(t.errors) == 0 {
+		return nil
+	}
+
+	return errors.New("paginatortest: " + strings.Join(t.errors, "\n\t"))
+}
+
+// testNums checks the number of items, or HasMore if the adapter can't count them
+func (t *tester) testNums(a paginator.Adapter, n int) {
+	got, err := nums(a)
+	if err != nil {
+		t.errorf("Nums(): %v", err)
+		return
+	}
+
+	if got != paginator.UnknownNums {
+		if got != int64(n) {
+			t.errorf("Nums(): got %d, want %d", got, n)
+		}
+
+		return
+	}
+
+	peeker, ok := a.(paginator.Peeker)
+	if !ok {
+		t.errorf("Nums(): got paginator.UnknownNums but %T doesn't implement paginator.Peeker", a)
+		return
+	}
+
+	for _, offset := range []int{0, n - 1, n, n + 10} {
+		if offset < 0 {
+			continue
+		}
+
+		more, err := hasMore(peeker, offset)
+		if err != nil {
+			t.errorf("HasMore(%d): %v", offset, err)
+		} else if more != (offset < n) {
+			t.errorf("HasMore(%d): got %t, want %t", offset, more, offset < n)
+		}
+	}
+}
+
+// testSlices checks the slices of every page for several page sizes
+func (t *tester) testSlices(a paginator.Adapter) {
+	n := t.items.Len()
+	sizes := append(append([]int{}, pageSizes...), n, n+1)
+	for _, size := range sizes {
+		for offset := 0; offset < n; offset += size {
+			t.testSlice(a, offset, size)
+		}
+	}
+
+	t.testSlice(a, 0, 0)
+}
+
+// testOffsetBeyondEnd checks the slices starting at or after the last item are empty
+func (t *tester) testOffsetBeyondEnd(a paginator.Adapter) {
+	n := t.items.Len()
+	t.testSlice(a, n, 10)
+	t.testSlice(a, n+10, 10)
+}
+
+// testDestinations checks the previous elements of the destination are dropped and the invalid destinations fail
+func (t *tester) testDestinations(a paginator.Adapter) {
+	dest := reflect.New(t.items.Type())
+	dest.Elem().Set(t.items)
+	if err := slice(a, 0, 1, dest.Interface()); err != nil {
+		t.errorf("Slice(0, 1) into non-empty destination: %v", err)
+	} else if dest.Elem().Len() != 1 {
+		t.errorf("Slice(0, 1) into non-empty destination: got %d items, want 1", dest.Elem().Len())
+	}
+
+	invalid := []interface{}{
+		nil,
+		reflect.MakeSlice(t.items.Type(), 0, 0).Interface(),
+		new(int),
+	}
+
+	for _, dest := range invalid {
+		err := slice(a, 0, 1, dest)
+		var p panicError
+		switch {
+		case err == nil:
+			t.errorf("Slice(0, 1) into %T: got no error", dest)
+		case errors.As(err, &p):
+			t.errorf("Slice(0, 1) into %T: %v", dest, err)
+		}
+	}
+}
+
+// testEmpty checks an empty source has no items
+func (t *tester) testEmpty(a paginator.Adapter) {
+	t.testNums(a, 0)
+
+	dest := reflect.New(t.items.Type())
+	if err := slice(a, 0, 10, dest.Interface()); err != nil {
+		t.errorf("empty source: Slice(0, 10): %v", err)
+	} else if dest.Elem().Len() != 0 {
+		t.errorf("empty source: Slice(0, 10): got %v, want no items", dest.Elem())
+	}
+}
+
+// testFailing checks the errors of the data source are returned
+func (t *tester) testFailing(a paginator.Adapter) {
+	if err := slice(a, 0, 10, reflect.New(t.items.Type()).Interface()); err == nil {
+		t.errorf("failing source: Slice(0, 10): got no error")
+	}
+}
+
+// testSlice checks the slice at offset holds the expected items
+func (t *tester) testSlice(a paginator.Adapter, offset, length int) {
+	n := t.items.Len()
+	from, to := offset, offset+length
+	if from > n {
+		from = n
+	}
+
+	if to > n {
+		to = n
+	}
+
+	want := t.items.Slice(from, to)
+	dest := reflect.New(t.items.Type())
+	if err := slice(a, offset, length, dest.Interface()); err != nil {
+		t.errorf("Slice(%d, %d): %v", offset, length, err)
+		return
+	}
+
+	got := dest.Elem()
+	if got.Len() != want.Len() || (want.Len() > 0 && !reflect.DeepEqual(got.Interface(), want.Interface())) {
+		t.errorf("Slice(%d, %d): got %v, want %v", offset, length, got, want)
+	}
+}
+
+// nums calls Nums and turns a panic into an error
+func nums(a paginator.Adapter) (n int64, err error) {
+	defer recoverError(&err)
+
+	return a.Nums()
+}
+
+// hasMore calls HasMore and turns a panic into an error
+func hasMore(p paginator.Peeker, offset int) (more bool, err error) {
+	defer recoverError(&err)
+
+	return p.HasMore(offset)
+}
+
+// slice calls Slice and turns a panic into an error
+func slice(a paginator.Adapter, offset, length int, dest interface{}) (err error) {
+	defer recoverError(&err)
+
+	return a.Slice(offset, length, dest)
+}
+
+func recoverError(err *error) {
+	if r := recover(); r != nil {
+		*err = panicError{value: r}
+	}
+}
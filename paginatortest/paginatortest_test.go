@@ -0,0 +1,192 @@
+package paginatortest_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"github.com/vcraescu/go-paginator/v2/paginatortest"
+	"testing"
+)
+
+type (
+	// offByOneAdapter counts one item too many
+	offByOneAdapter struct {
+		paginator.Adapter
+	}
+
+	// appendingAdapter appends to the destination instead of replacing its elements
+	appendingAdapter struct {
+		items []int
+	}
+
+	// unclampedAdapter panics on the offsets beyond the end
+	unclampedAdapter struct {
+		items []int
+	}
+
+	// failingAdapter ignores the errors of its data source
+	failingAdapter struct{}
+
+	TestAdapterTestSuite struct {
+		suite.Suite
+		items []int
+	}
+)
+
+func (a offByOneAdapter) Nums() (int64, error) {
+	n, err := a.Adapter.Nums()
+
+	return n + 1, err
+}
+
+func (a appendingAdapter) Nums() (int64, error) {
+	return int64(len(a.items)), nil
+}
+
+func (a appendingAdapter) Slice(offset, length int, dest interface{}) error {
+	end := offset + length
+	if end > len(a.items) {
+		end = len(a.items)
+	}
+
+	if offset < end {
+		*dest.(*[]int) = append(*dest.(*[]int), a.items[offset:end]...)
+	}
+
+	return nil
+}
+
+func (a unclampedAdapter) Nums() (int64, error) {
+	return int64(len(a.items)), nil
+}
+
+func (a unclampedAdapter) Slice(offset, length int, dest interface{}) error {
+	end := offset + length
+	if end > len(a.items) {
+		end = len(a.items)
+	}
+
+	*dest.(*[]int) = append([]int{}, a.items[offset:end]...)
+
+	return nil
+}
+
+func (failingAdapter) Nums() (int64, error) {
+	return 0, nil
+}
+
+func (failingAdapter) Slice(offset, length int, dest interface{}) error {
+	return nil
+}
+
+func (suite *TestAdapterTestSuite) SetupTest() {
+	suite.items = make([]int, 25)
+	for i := range suite.items {
+		suite.items[i] = i + 1
+	}
+}
+
+func (suite *TestAdapterTestSuite) config(newAdapter func(items []int) paginator.Adapter) paginatortest.Config {
+	return paginatortest.Config{
+		New: func(n int) (paginator.Adapter, error) {
+			return newAdapter(suite.items[:n]), nil
+		},
+		Items: suite.items,
+	}
+}
+
+func (suite *TestAdapterTestSuite) TestConformingAdapter() {
+	cfg := suite.config(func(items []int) paginator.Adapter {
+		return adapter.NewSliceAdapter(items)
+	})
+	cfg.NewFailing = func() (paginator.Adapter, error) {
+		return adapter.NewSliceAdapter(nil), nil
+	}
+
+	suite.Require().NoError(paginatortest.TestAdapter(cfg))
+}
+
+func (suite *TestAdapterTestSuite) TestCountFreeAdapter() {
+	suite.Require().NoError(paginatortest.TestAdapter(suite.config(func(items []int) paginator.Adapter {
+		ch := make(chan int, len(items))
+		for _, item := range items {
+			ch <- item
+		}
+		close(ch)
+
+		return adapter.NewChanAdapter(ch)
+	})))
+}
+
+func (suite *TestAdapterTestSuite) TestNonConformingAdapters() {
+	tests := []struct {
+		name     string
+		cfg      paginatortest.Config
+		contains []string
+	}{
+		{
+			name: "wrong count",
+			cfg: suite.config(func(items []int) paginator.Adapter {
+				return offByOneAdapter{Adapter: adapter.NewSliceAdapter(items)}
+			}),
+			contains: []string{"Nums(): got 26, want 25", "Nums(): got 1, want 0"},
+		},
+		{
+			name: "appending to destination",
+			cfg: suite.config(func(items []int) paginator.Adapter {
+				return appendingAdapter{items: items}
+			}),
+			contains: []string{"Slice(0, 1) into non-empty destination: got 26 items, want 1"},
+		},
+		{
+			name: "offset beyond end",
+			cfg: suite.config(func(items []int) paginator.Adapter {
+				return unclampedAdapter{items: items}
+			}),
+			contains: []string{"Slice(35, 10): panic: runtime error", "Slice(0, 1) into <nil>: panic:"},
+		},
+		{
+			name: "ignored errors",
+			cfg: paginatortest.Config{
+				New: func(n int) (paginator.Adapter, error) {
+					return adapter.NewSliceAdapter(suite.items[:n]), nil
+				},
+				Items: suite.items,
+				NewFailing: func() (paginator.Adapter, error) {
+					return failingAdapter{}, nil
+				},
+			},
+			contains: []string{"failing source: Slice(0, 10): got no error"},
+		},
+		{
+			name: "failing constructor",
+			cfg: paginatortest.Config{
+				New: func(n int) (paginator.Adapter, error) {
+					return nil, errors.New("no database")
+				},
+				Items: suite.items,
+			},
+			contains: []string{"paginatortest: New(25): no database"},
+		},
+		{
+			name:     "no items",
+			cfg:      paginatortest.Config{Items: []int{}},
+			contains: []string{"paginatortest: expected non-empty slice of items but got []int"},
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			err := paginatortest.TestAdapter(test.cfg)
+			suite.Require().Error(err)
+			for _, s := range test.contains {
+				suite.Require().Contains(err.Error(), s)
+			}
+		})
+	}
+}
+
+func TestTestAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(TestAdapterTestSuite))
+}
@@ -0,0 +1,249 @@
+package query
+
+import (
+	"fmt"
+	"github.com/vcraescu/go-paginator/v2"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxPerPage default upper limit for the page size requested through the query string
+const DefaultMaxPerPage = 100
+
+type (
+	// Sort a field to sort by
+	Sort struct {
+		Field string
+		Desc  bool
+	}
+
+	// Params paginator configuration parsed from a query string
+	Params struct {
+		Page    int
+		PerPage int
+		Sort    []Sort
+		Filters map[string]string
+	}
+
+	// Parser parses query strings into Params and builds query strings back from Params.
+	// Only the fields listed in SortFields and FilterFields can be sorted and filtered by;
+	// an empty list rejects every field, since the fields usually end up in the ORDER BY and WHERE clauses.
+	Parser struct {
+		PageParam      string
+		PerPageParam   string
+		SortParam      string
+		FilterParam    string
+		DefaultPerPage int
+		MaxPerPage     int
+		SortFields     []string
+		FilterFields   []string
+	}
+
+	// ValidationError describes an invalid query string parameter
+	ValidationError struct {
+		Param   string
+		Message string
+	}
+
+	// ValidationErrors all the invalid parameters of a query string
+	ValidationErrors []ValidationError
+)
+
+// Error implements error interface
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Param, e.Message)
+}
+
+// Error implements error interface
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// NewParser Parser constructor using page, per_page, sort and filter parameter names.
+func NewParser() *Parser {
+	return &Parser{
+		PageParam:      "page",
+		PerPageParam:   "per_page",
+		SortParam:      "sort",
+		FilterParam:    "filter",
+		DefaultPerPage: paginator.DefaultMaxPerPage,
+		MaxPerPage:     DefaultMaxPerPage,
+	}
+}
+
+// Parse turns the query string values into Params.
+// It returns ValidationErrors if any of the parameters is invalid.
+func (p *Parser) Parse(values url.Values) (*Params, error) {
+	var errs ValidationErrors
+	params := &Params{
+		Page:    1,
+		PerPage: p.DefaultPerPage,
+		Filters: make(map[string]string),
+	}
+
+	if v := values.Get(p.PageParam); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			errs = append(errs, ValidationError{p.PageParam, "must be a positive integer"})
+		} else {
+			params.Page = n
+		}
+	}
+
+	if v := values.Get(p.PerPageParam); v != "" {
+		n, err := strconv.Atoi(v)
+		switch {
+		case err != nil || n <= 0:
+			errs = append(errs, ValidationError{p.PerPageParam, "must be a positive integer"})
+		case p.MaxPerPage > 0 && n > p.MaxPerPage:
+			errs = append(errs, ValidationError{p.PerPageParam, fmt.Sprintf("must not be greater than %d", p.MaxPerPage)})
+		default:
+			params.PerPage = n
+		}
+	}
+
+	if v := values.Get(p.SortParam); v != "" {
+		sorts, sortErrs := p.parseSort(v)
+		params.Sort = sorts
+		errs = append(errs, sortErrs...)
+	}
+
+	for key, vals := range values {
+		field, ok := p.filterField(key)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case field == "":
+			errs = append(errs, ValidationError{key, "missing filter field name"})
+		case !allowed(p.FilterFields, field):
+			errs = append(errs, ValidationError{key, fmt.Sprintf("filtering by %q is not allowed", field)})
+		default:
+			params.Filters[field] = vals[0]
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool {
+			return errs[i].Param < errs[j].Param
+		})
+
+		return nil, errs
+	}
+
+	return params, nil
+}
+
+// Values builds the canonical query string values for params.
+// Parameters having the default value are omitted.
+func (p *Parser) Values(params Params) url.Values {
+	values := url.Values{}
+	if params.Page > 1 {
+		values.Set(p.PageParam, strconv.Itoa(params.Page))
+	}
+
+	if params.PerPage > 0 && params.PerPage != p.DefaultPerPage {
+		values.Set(p.PerPageParam, strconv.Itoa(params.PerPage))
+	}
+
+	if len(params.Sort) > 0 {
+		fields := make([]string, len(params.Sort))
+		for i, s := range params.Sort {
+			fields[i] = s.String()
+		}
+
+		values.Set(p.SortParam, strings.Join(fields, ","))
+	}
+
+	for field, value := range params.Filters {
+		values.Set(fmt.Sprintf("%s[%s]", p.FilterParam, field), value)
+	}
+
+	return values
+}
+
+// Encode builds the canonical query string for params
+func (p *Parser) Encode(params Params) string {
+	return p.Values(params).Encode()
+}
+
+func (p *Parser) parseSort(v string) ([]Sort, ValidationErrors) {
+	var (
+		sorts []Sort
+		errs  ValidationErrors
+	)
+
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(v, ",") {
+		s := Sort{Field: field}
+		if strings.HasPrefix(field, "-") {
+			s = Sort{Field: field[1:], Desc: true}
+		}
+
+		switch {
+		case s.Field == "":
+			errs = append(errs, ValidationError{p.SortParam, "empty sort field"})
+		case !allowed(p.SortFields, s.Field):
+			errs = append(errs, ValidationError{p.SortParam, fmt.Sprintf("sorting by %q is not allowed", s.Field)})
+		case seen[s.Field]:
+			errs = append(errs, ValidationError{p.SortParam, fmt.Sprintf("duplicate sort field %q", s.Field)})
+		default:
+			seen[s.Field] = true
+			sorts = append(sorts, s)
+		}
+	}
+
+	return sorts, errs
+}
+
+// filterField extracts the field name out of a filter[field] parameter name
+func (p *Parser) filterField(key string) (string, bool) {
+	prefix := p.FilterParam + "["
+	if !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, "]") {
+		return "", false
+	}
+
+	return key[len(prefix) : len(key)-1], true
+}
+
+// String returns the sort field as it appears in the query string
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Field
+	}
+
+	return s.Field
+}
+
+// WithPage returns a copy of params pointing to another page
+func (p Params) WithPage(page int) Params {
+	p.Page = page
+
+	return p
+}
+
+// Paginator creates a paginator for the adapter configured with the page and the page size of params
+func (p Params) Paginator(adapter paginator.Adapter) paginator.Paginator {
+	pg := paginator.New(adapter, p.PerPage)
+	pg.SetPage(p.Page)
+
+	return pg
+}
+
+func allowed(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,158 @@
+package query_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/adapter"
+	"github.com/vcraescu/go-paginator/v2/query"
+	"net/url"
+	"testing"
+)
+
+type ParserTestSuite struct {
+	suite.Suite
+	parser *query.Parser
+}
+
+func (suite *ParserTestSuite) SetupTest() {
+	suite.parser = query.NewParser()
+	suite.parser.SortFields = []string{"date", "title"}
+	suite.parser.FilterFields = []string{"status", "author"}
+}
+
+func (suite *ParserTestSuite) TestParse() {
+	require := suite.Require()
+	values, err := url.ParseQuery("page=3&per_page=25&sort=-date,title&filter[status]=open")
+	require.NoError(err)
+
+	params, err := suite.parser.Parse(values)
+	require.NoError(err)
+	require.Equal(3, params.Page)
+	require.Equal(25, params.PerPage)
+	require.Equal([]query.Sort{{Field: "date", Desc: true}, {Field: "title"}}, params.Sort)
+	require.Equal(map[string]string{"status": "open"}, params.Filters)
+}
+
+func (suite *ParserTestSuite) TestParseDefaults() {
+	require := suite.Require()
+
+	params, err := suite.parser.Parse(url.Values{})
+	require.NoError(err)
+	require.Equal(1, params.Page)
+	require.Equal(paginator.DefaultMaxPerPage, params.PerPage)
+	require.Empty(params.Sort)
+	require.Empty(params.Filters)
+}
+
+func (suite *ParserTestSuite) TestParseValidationErrors() {
+	require := suite.Require()
+	suite.parser.SortFields = []string{"date"}
+	suite.parser.FilterFields = []string{"status"}
+
+	values, err := url.ParseQuery("page=-1&per_page=500&sort=title,date,-date&filter[author]=me&filter[]=x")
+	require.NoError(err)
+
+	_, err = suite.parser.Parse(values)
+	require.Error(err)
+
+	errs, ok := err.(query.ValidationErrors)
+	require.True(ok)
+	require.Equal(query.ValidationErrors{
+		{Param: "filter[]", Message: "missing filter field name"},
+		{Param: "filter[author]", Message: `filtering by "author" is not allowed`},
+		{Param: "page", Message: "must be a positive integer"},
+		{Param: "per_page", Message: "must not be greater than 100"},
+		{Param: "sort", Message: `sorting by "title" is not allowed`},
+		{Param: "sort", Message: `duplicate sort field "date"`},
+	}, errs)
+}
+
+func (suite *ParserTestSuite) TestParseWithoutAllowedFields() {
+	require := suite.Require()
+	parser := query.NewParser()
+
+	values, err := url.ParseQuery("sort=date&filter[status]=open")
+	require.NoError(err)
+
+	_, err = parser.Parse(values)
+	require.Equal(query.ValidationErrors{
+		{Param: "filter[status]", Message: `filtering by "status" is not allowed`},
+		{Param: "sort", Message: `sorting by "date" is not allowed`},
+	}, err)
+}
+
+func (suite *ParserTestSuite) TestParseSortPlusPrefix() {
+	require := suite.Require()
+
+	// + decodes to a space in a query string, so it's not an ascending prefix
+	values, err := url.ParseQuery("sort=+date")
+	require.NoError(err)
+
+	_, err = suite.parser.Parse(values)
+	require.EqualError(err, `sort: sorting by " date" is not allowed`)
+}
+
+func (suite *ParserTestSuite) TestParseInvalidNumbers() {
+	require := suite.Require()
+	values, err := url.ParseQuery("page=abc&per_page=0")
+	require.NoError(err)
+
+	_, err = suite.parser.Parse(values)
+	require.EqualError(err, "page: must be a positive integer; per_page: must be a positive integer")
+}
+
+func (suite *ParserTestSuite) TestEncode() {
+	require := suite.Require()
+	params := query.Params{
+		Page:    2,
+		PerPage: 25,
+		Sort:    []query.Sort{{Field: "date", Desc: true}},
+		Filters: map[string]string{"status": "open", "author": "me"},
+	}
+
+	qs := suite.parser.Encode(params)
+	require.Equal("filter%5Bauthor%5D=me&filter%5Bstatus%5D=open&page=2&per_page=25&sort=-date", qs)
+
+	values, err := url.ParseQuery(qs)
+	require.NoError(err)
+
+	parsed, err := suite.parser.Parse(values)
+	require.NoError(err)
+	require.Equal(params, *parsed)
+}
+
+func (suite *ParserTestSuite) TestEncodeOmitsDefaults() {
+	require := suite.Require()
+	params := query.Params{Page: 1, PerPage: paginator.DefaultMaxPerPage}
+
+	require.Equal("", suite.parser.Encode(params))
+	require.Equal("page=4", suite.parser.Encode(params.WithPage(4)))
+}
+
+func (suite *ParserTestSuite) TestPaginator() {
+	require := suite.Require()
+	data := make([]int, 100)
+	for i := 1; i <= 100; i++ {
+		data[i-1] = i
+	}
+
+	values, err := url.ParseQuery("page=3&per_page=25")
+	require.NoError(err)
+
+	params, err := suite.parser.Parse(values)
+	require.NoError(err)
+
+	p := params.Paginator(adapter.NewSliceAdapter(data))
+	pn, err := p.PageNums()
+	require.NoError(err)
+	require.Equal(4, pn)
+
+	var items []int
+	require.NoError(p.Results(&items))
+	require.Len(items, 25)
+	require.Equal(51, items[0])
+}
+
+func TestParserTestSuite(t *testing.T) {
+	suite.Run(t, new(ParserTestSuite))
+}
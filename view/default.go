@@ -64,25 +64,21 @@ func (v *DefaultView) Pages() ([]int, error) {
 
 	items = make([]int, 0)
 	length := v.Proximity * 2
+	if length < 1 {
+		return items, nil
+	}
+
 	pn, err := v.Paginator.PageNums()
 	if err != nil {
 		return nil, err
 	}
 
 	if pn < length {
-		pn, err := v.Paginator.PageNums()
-		if err != nil {
-			return nil, err
-		}
-
 		length = pn
 	}
 
 	proximityLeft := length / 2
-	proximityRight := (length / 2) - 1
-	if length%2 != 0 {
-		proximityRight = proximityLeft
-	}
+	proximityRight := length - proximityLeft - 1
 
 	page, err := v.Paginator.Page()
 	if err != nil {
@@ -96,6 +92,12 @@ func (v *DefaultView) Pages() ([]int, error) {
 		end = length
 	}
 
+	// keep the window within the last page
+	if end > pn {
+		end = pn
+		start = pn - length + 1
+	}
+
 	for page = start; page <= end; page++ {
 		items = append(items, page)
 	}
@@ -0,0 +1,34 @@
+//go:build go1.18
+// +build go1.18
+
+package view_test
+
+import (
+	"testing"
+)
+
+func FuzzInvariants(f *testing.F) {
+	f.Add(150, 10, 15, 5, false)
+	f.Add(70, 10, 4, 5, false)
+	f.Add(0, 10, 1, 5, false)
+	f.Add(25, 3, 100, 2, true)
+
+	f.Fuzz(func(t *testing.T, total, perPage, page, proximity int, countFree bool) {
+		// keep the sizes small enough to allocate the items and the pages
+		total = mod(total, 10000)
+		perPage = mod(perPage, 200) - 10
+		page = mod(page, 2000) - 10
+		proximity = mod(proximity, 50) - 5
+
+		checkInvariants(t, total, perPage, page, proximity, countFree)
+	})
+}
+
+func mod(n, m int) int {
+	n %= m
+	if n < 0 {
+		n += m
+	}
+
+	return n
+}
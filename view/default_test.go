@@ -144,7 +144,7 @@ func (suite *DefaultViewTestSuite) TestItemsLastCurrentPage() {
 	require.Equal(paginator.ErrNoNextPage, err)
 
 	for i, page := range pages {
-		require.Equal(i+10, page)
+		require.Equal(i+6, page)
 	}
 }
 
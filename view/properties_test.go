@@ -0,0 +1,149 @@
+package view_test
+
+import (
+	"github.com/vcraescu/go-paginator/v2"
+	"github.com/vcraescu/go-paginator/v2/paginatortest"
+	"github.com/vcraescu/go-paginator/v2/view"
+	"testing"
+)
+
+// checkInvariants checks the paginator and the default view of total items, with perPage items per page, the current
+// page set to page and the given proximity. countFree hides the number of items from the paginator.
+func checkInvariants(t testing.TB, total, perPage, page, proximity int, countFree bool) {
+	t.Helper()
+
+	data := make([]int, total)
+	for i := range data {
+		data[i] = i + 1
+	}
+
+	a := paginatortest.NewMockAdapter(data)
+	if countFree {
+		a.NumsFunc = func() (int64, error) {
+			return paginator.UnknownNums, nil
+		}
+	}
+
+	p := paginator.New(a, perPage)
+	p.SetPage(page)
+	v := &view.DefaultView{Paginator: p, Proximity: proximity}
+
+	if perPage <= 0 {
+		perPage = paginator.DefaultMaxPerPage
+	}
+
+	// the expected current page and number of items on it
+	current := page
+	if current < 1 {
+		current = 1
+	}
+
+	pn, err := p.PageNums()
+	if err != nil {
+		t.Fatalf("PageNums(): %v", err)
+	}
+
+	// a page past the end is stepped back to the last page in both modes
+	last := (total + perPage - 1) / perPage
+	if last < 1 {
+		last = 1
+	}
+
+	if current > last {
+		current = last
+	}
+
+	want := last
+	if countFree && current < last {
+		// only the next page is known without a total
+		want = current + 1
+	}
+
+	if pn != want {
+		t.Fatalf("PageNums(): got %d, want %d", pn, want)
+	}
+
+	if got, err := p.Page(); err != nil || got != current {
+		t.Fatalf("Page(): got %d, %v, want %d", got, err, current)
+	}
+
+	size := total - (current-1)*perPage
+	if size > perPage {
+		size = perPage
+	}
+
+	if size < 0 {
+		size = 0
+	}
+
+	var results []int
+	if err := p.Results(&results); err != nil {
+		t.Fatalf("Results(): %v", err)
+	}
+
+	if len(results) != size {
+		t.Fatalf("Results(): got %d items, want %d", len(results), size)
+	}
+
+	pages, err := v.Pages()
+	if err != nil {
+		t.Fatalf("Pages(): %v", err)
+	}
+
+	if pn <= 1 {
+		if len(pages) != 0 {
+			t.Fatalf("Pages(): got %v for a single page", pages)
+		}
+
+		return
+	}
+
+	// no pages are shown without proximity
+	length := 2 * proximity
+	if length < 1 {
+		if len(pages) != 0 {
+			t.Fatalf("Pages(): got %v for proximity %d", pages, proximity)
+		}
+
+		return
+	}
+
+	if length > pn {
+		length = pn
+	}
+
+	if len(pages) != length {
+		t.Fatalf("Pages(): got %v, want %d pages", pages, length)
+	}
+
+	contains := false
+	for i, n := range pages {
+		if n < 1 || n > pn {
+			t.Fatalf("Pages(): got %v, page %d out of [1, %d]", pages, n, pn)
+		}
+
+		if i > 0 && n != pages[i-1]+1 {
+			t.Fatalf("Pages(): got %v, not contiguous", pages)
+		}
+
+		contains = contains || n == current
+	}
+
+	if !contains {
+		t.Fatalf("Pages(): got %v, missing current page %d", pages, current)
+	}
+}
+
+func TestInvariants(t *testing.T) {
+	for _, countFree := range []bool{false, true} {
+		for total := 0; total <= 45; total++ {
+			for perPage := 0; perPage <= 12; perPage++ {
+				for page := -1; page <= 50; page++ {
+					for proximity := 0; proximity <= 6; proximity++ {
+						checkInvariants(t, total, perPage, page, proximity, countFree)
+					}
+				}
+			}
+		}
+	}
+}